@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// askpassCommand implements git's GIT_ASKPASS protocol: git execs the
+// program named by GIT_ASKPASS with the prompt text as its one
+// argument and reads the answer from stdout. AuthConfig.Env sets
+// GIT_ASKPASS to this binary plus "askpass" so PushBeadAs can
+// authenticate an HTTPS remote from FIRE_FLOW_TOKEN_ENV without the
+// token ever appearing in argv, logs, or a separate credential-helper
+// binary that would need its own install step.
+type askpassCommand struct{}
+
+func (askpassCommand) Name() string     { return "askpass" }
+func (askpassCommand) Synopsis() string { return "git GIT_ASKPASS helper (internal use by push)" }
+
+func (askpassCommand) Run(args []string) error {
+	envVar := os.Getenv("FIRE_FLOW_TOKEN_ENV")
+	if envVar == "" {
+		return fmt.Errorf("askpass: FIRE_FLOW_TOKEN_ENV is not set; this command is only meant to be run by git via GIT_ASKPASS")
+	}
+	token := os.Getenv(envVar)
+	if token == "" {
+		return fmt.Errorf("askpass: %s is empty", envVar)
+	}
+
+	prompt := ""
+	if len(args) > 0 {
+		prompt = args[0]
+	}
+	if strings.Contains(strings.ToLower(prompt), "username") {
+		// The username itself is not a secret; git's HTTPS token flow
+		// (e.g. GitHub, GitLab) accepts any non-empty username paired
+		// with the token as the password.
+		fmt.Println("fire-flow")
+		return nil
+	}
+	fmt.Println(token)
+	return nil
+}
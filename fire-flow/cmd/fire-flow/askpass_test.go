@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestAskpassCommandPrintsTokenForPasswordPrompt exercises askpassCommand
+// through a real subprocess, since it reads FIRE_FLOW_TOKEN_ENV from the
+// process environment the way git would actually invoke it, not just
+// from this test binary's own environment.
+func TestAskpassCommandPrintsTokenForPasswordPrompt(t *testing.T) {
+	bin := buildFireFlowBinary(t)
+
+	cmd := exec.Command(bin, "askpass", "Password for 'https://example.com':")
+	cmd.Env = append(os.Environ(), "FIRE_FLOW_TOKEN_ENV=FIRE_FLOW_TEST_ASKPASS_TOKEN", "FIRE_FLOW_TEST_ASKPASS_TOKEN=abc123")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("askpass: %v", err)
+	}
+	if got := strings.TrimSpace(string(out)); got != "abc123" {
+		t.Fatalf("askpass output = %q, want the token", got)
+	}
+}
+
+func TestAskpassCommandAnswersUsernamePromptWithoutTheToken(t *testing.T) {
+	bin := buildFireFlowBinary(t)
+
+	cmd := exec.Command(bin, "askpass", "Username for 'https://example.com':")
+	cmd.Env = append(os.Environ(), "FIRE_FLOW_TOKEN_ENV=FIRE_FLOW_TEST_ASKPASS_TOKEN", "FIRE_FLOW_TEST_ASKPASS_TOKEN=abc123")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("askpass: %v", err)
+	}
+	if strings.Contains(string(out), "abc123") {
+		t.Fatalf("askpass answered a username prompt with the token: %q", out)
+	}
+}
+
+func TestAskpassCommandFailsWithoutTokenEnvVar(t *testing.T) {
+	bin := buildFireFlowBinary(t)
+
+	cmd := exec.Command(bin, "askpass", "Password:")
+	cmd.Env = os.Environ()
+	if err := cmd.Run(); err == nil {
+		t.Fatal("askpass without FIRE_FLOW_TOKEN_ENV set should fail")
+	}
+}
+
+// buildFireFlowBinary compiles this package once per test run so
+// askpassCommand can be exercised the way git actually invokes it: as a
+// separate process reading its own environment.
+func buildFireFlowBinary(t *testing.T) string {
+	t.Helper()
+	bin := t.TempDir() + "/fire-flow"
+	build := exec.Command("go", "build", "-o", bin, ".")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("building fire-flow: %v: %s", err, out)
+	}
+	return bin
+}
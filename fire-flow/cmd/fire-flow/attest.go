@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/lprior-repo/fire-flow/internal/attestation"
+	"github.com/lprior-repo/fire-flow/internal/history"
+)
+
+// attestCommand implements `fire-flow attest`, generating and writing a
+// SLSA-style provenance attestation for one bead's landed commit. The
+// orchestrator runs this right after the commit step, once a cycle's
+// outcome is already in the history log, since that log is the only
+// record of what tests ran and what decision was made.
+type attestCommand struct{}
+
+func (attestCommand) Name() string { return "attest" }
+func (attestCommand) Synopsis() string {
+	return "write a provenance attestation for a bead's landed commit"
+}
+
+func (attestCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("attest", flag.ContinueOnError)
+	historyPath := fs.String("history", ".opencode/tcr/history.jsonl", "path to the cycle history log")
+	beadID := fs.String("bead-id", "", "bead whose most recent history entry to attest")
+	commitSHA := fs.String("commit", "", "commit SHA the cycle landed")
+	model := fs.String("model", "", "model that generated the change")
+	promptFile := fs.String("prompt-file", "", "path to the prompt used, hashed rather than stored so the attestation never contains prompt text")
+	dir := fs.String("dir", ".opencode/tcr/attestations", "directory to write the attestation to")
+	uploadEndpoint := fs.String("upload-endpoint", "", "optional HTTP endpoint to also POST the attestation to")
+	uploadTokenEnv := fs.String("upload-token-env", "", "env var holding the bearer token for -upload-endpoint")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *beadID == "" || *commitSHA == "" {
+		return fmt.Errorf("usage: fire-flow attest -bead-id <id> -commit <sha> [-model name] [-prompt-file path]")
+	}
+
+	page, err := history.Read(*historyPath, history.Query{BeadID: *beadID})
+	if err != nil {
+		return err
+	}
+	if len(page.Entries) == 0 {
+		return fmt.Errorf("no history entry for bead %s in %s", *beadID, *historyPath)
+	}
+	summary := page.Entries[len(page.Entries)-1]
+
+	promptHash, err := hashPromptFile(*promptFile)
+	if err != nil {
+		return err
+	}
+
+	stmt := attestation.Build(summary, *commitSHA, *model, promptHash)
+	path, err := attestation.Write(*dir, *beadID, *commitSHA, stmt)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("wrote attestation for bead %s to %s\n", *beadID, path)
+
+	if *uploadEndpoint != "" {
+		uploader := attestation.HTTPUploader{Endpoint: *uploadEndpoint, Token: os.Getenv(*uploadTokenEnv)}
+		if err := uploader.Upload(context.Background(), stmt); err != nil {
+			return fmt.Errorf("uploading attestation: %w", err)
+		}
+		fmt.Printf("uploaded attestation for bead %s to %s\n", *beadID, *uploadEndpoint)
+	}
+	return nil
+}
+
+// hashPromptFile returns the hex sha256 of promptFile's contents, or an
+// empty hash when promptFile isn't set, so attest can run for cycles
+// that didn't capture a prompt.
+func hashPromptFile(promptFile string) (string, error) {
+	if promptFile == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(promptFile)
+	if err != nil {
+		return "", fmt.Errorf("reading -prompt-file %s: %w", promptFile, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lprior-repo/fire-flow/internal/attestation"
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+)
+
+func writeTestHistory(t *testing.T, path string, entries ...cycle.Summary) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("creating history file: %v", err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			t.Fatalf("encoding history entry: %v", err)
+		}
+	}
+}
+
+func TestAttestCommandWritesAttestationForBead(t *testing.T) {
+	dir := t.TempDir()
+	historyPath := filepath.Join(dir, "history.jsonl")
+	writeTestHistory(t, historyPath,
+		cycle.Summary{BeadID: "bead-1", Decision: cycle.DecisionCommitted, TestsPassed: 3},
+		cycle.Summary{BeadID: "bead-2", Decision: cycle.DecisionCommitted, TestsPassed: 1},
+	)
+	attestDir := filepath.Join(dir, "attestations")
+
+	err := (attestCommand{}).Run([]string{
+		"-history", historyPath,
+		"-bead-id", "bead-2",
+		"-commit", "deadbeef",
+		"-model", "test-model",
+		"-dir", attestDir,
+	})
+	if err != nil {
+		t.Fatalf("attestCommand.Run: %v", err)
+	}
+
+	path := attestation.Path(attestDir, "bead-2", "deadbeef")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading written attestation: %v", err)
+	}
+	var stmt attestation.Statement
+	if err := json.Unmarshal(data, &stmt); err != nil {
+		t.Fatalf("parsing written attestation: %v", err)
+	}
+	if stmt.Predicate.BeadID != "bead-2" || stmt.Predicate.TestsPassed != 1 {
+		t.Fatalf("attestation predicate = %+v, want the bead-2 entry", stmt.Predicate)
+	}
+	if stmt.Subject[0].Digest["sha1"] != "deadbeef" {
+		t.Fatalf("attestation subject digest = %v, want deadbeef", stmt.Subject[0].Digest)
+	}
+}
+
+func TestAttestCommandRejectsMissingBead(t *testing.T) {
+	dir := t.TempDir()
+	historyPath := filepath.Join(dir, "history.jsonl")
+	writeTestHistory(t, historyPath, cycle.Summary{BeadID: "bead-1"})
+
+	err := (attestCommand{}).Run([]string{
+		"-history", historyPath,
+		"-bead-id", "bead-nonexistent",
+		"-commit", "deadbeef",
+		"-dir", filepath.Join(dir, "attestations"),
+	})
+	if err == nil {
+		t.Fatal("attest for a bead with no history entry should fail")
+	}
+}
+
+func TestAttestCommandHashesPromptFileWithoutStoringItsContent(t *testing.T) {
+	dir := t.TempDir()
+	historyPath := filepath.Join(dir, "history.jsonl")
+	writeTestHistory(t, historyPath, cycle.Summary{BeadID: "bead-1", Decision: cycle.DecisionCommitted})
+
+	promptPath := filepath.Join(dir, "prompt.txt")
+	if err := os.WriteFile(promptPath, []byte("do the thing"), 0o644); err != nil {
+		t.Fatalf("writing prompt file: %v", err)
+	}
+	attestDir := filepath.Join(dir, "attestations")
+
+	err := (attestCommand{}).Run([]string{
+		"-history", historyPath,
+		"-bead-id", "bead-1",
+		"-commit", "cafef00d",
+		"-prompt-file", promptPath,
+		"-dir", attestDir,
+	})
+	if err != nil {
+		t.Fatalf("attestCommand.Run: %v", err)
+	}
+
+	data, err := os.ReadFile(attestation.Path(attestDir, "bead-1", "cafef00d"))
+	if err != nil {
+		t.Fatalf("reading written attestation: %v", err)
+	}
+	if strings.Contains(string(data), "do the thing") {
+		t.Fatal("attestation file must not contain the raw prompt text")
+	}
+	var stmt attestation.Statement
+	if err := json.Unmarshal(data, &stmt); err != nil {
+		t.Fatalf("parsing written attestation: %v", err)
+	}
+	if stmt.Predicate.PromptHash == "" {
+		t.Fatal("attestation should record a non-empty prompt hash when -prompt-file is set")
+	}
+}
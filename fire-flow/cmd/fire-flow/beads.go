@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+	"github.com/lprior-repo/fire-flow/internal/tracker"
+)
+
+// beadsCommand implements `fire-flow beads import --from github|jira`,
+// converting tracker issues into beads, and `fire-flow beads archive`,
+// moving old closed beads out of the live JSONL file.
+type beadsCommand struct{}
+
+func (beadsCommand) Name() string     { return "beads" }
+func (beadsCommand) Synopsis() string { return "import beads from a tracker, or archive old closed beads" }
+
+func (beadsCommand) Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: fire-flow beads import --from github|jira [flags], or fire-flow beads archive [flags]")
+	}
+	switch args[0] {
+	case "import":
+		return runBeadsImport(args[1:])
+	case "archive":
+		return runBeadsArchive(args[1:])
+	default:
+		return fmt.Errorf("usage: fire-flow beads import --from github|jira [flags], or fire-flow beads archive [flags]")
+	}
+}
+
+func runBeadsImport(args []string) error {
+	fs := flag.NewFlagSet("beads import", flag.ContinueOnError)
+	from := fs.String("from", "", "tracker to import from: github or jira")
+	query := fs.String("query", "", "GitHub label or Jira JQL selecting issues to import")
+	repo := fs.String("repo", "", "GitHub repo as owner/name (github only)")
+	baseURL := fs.String("base-url", "", "Jira site base URL (jira only)")
+	email := fs.String("email", "", "Jira account email (jira only)")
+	beadsPath := fs.String("beads-file", ".beads/issues.jsonl", "beads JSONL file to append to")
+	idPrefix := fs.String("id-prefix", "import", "prefix for the temporary bead IDs")
+	checkDuplicates := fs.Bool("check-duplicates", true, "warn instead of importing when an existing open bead looks like a duplicate")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	provider, err := resolveProvider(*from, *repo, *baseURL, *email)
+	if err != nil {
+		return err
+	}
+
+	issues, err := provider.FetchIssues(*query)
+	if err != nil {
+		return err
+	}
+
+	existing, err := bead.ReadAll(*beadsPath)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	for i, issue := range issues {
+		imp := tracker.ToImport(issue)
+		description := imp.Description
+		if len(imp.AcceptanceCriteria) > 0 {
+			description += "\n\nAcceptance criteria:\n"
+			for _, c := range imp.AcceptanceCriteria {
+				description += "- " + c + "\n"
+			}
+		}
+		if len(imp.References) > 0 {
+			description += fmt.Sprintf("\nReferences: %v\n", imp.References)
+		}
+		description += fmt.Sprintf("\nImported from %s\n", imp.SourceRef)
+
+		if *checkDuplicates {
+			similar, err := bead.FindSimilar(ctx, bead.HashEmbedder{}, description, existing, bead.DefaultSimilarityThreshold)
+			if err != nil {
+				return fmt.Errorf("checking %s for duplicates: %w", imp.SourceRef, err)
+			}
+			if len(similar) > 0 {
+				fmt.Printf("skipping %s: looks like a duplicate of %s (similarity %.2f)\n", imp.SourceRef, similar[0].Issue.ID, similar[0].Similarity)
+				continue
+			}
+		}
+
+		created, err := bead.AppendProposal(*beadsPath, *idPrefix, imp.Title, description, 2, i+1)
+		if err != nil {
+			return fmt.Errorf("appending bead for %s: %w", imp.SourceRef, err)
+		}
+		existing = append(existing, created)
+		fmt.Printf("imported %s -> %s\n", imp.SourceRef, created.ID)
+	}
+	return nil
+}
+
+func runBeadsArchive(args []string) error {
+	fs := flag.NewFlagSet("beads archive", flag.ContinueOnError)
+	beadsPath := fs.String("beads-file", ".beads/issues.jsonl", "beads JSONL file to archive from")
+	archiveDir := fs.String("archive-dir", ".beads/archive", "directory to write compressed archive files to")
+	retention := fs.Duration("retention", 90*24*time.Hour, "how long a closed bead stays in the live file before archiving")
+	artifactsDir := fs.String("artifacts-dir", "", "artifact root to prune per archived bead ID (leave empty to skip pruning)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	result, err := bead.Archive(*beadsPath, *archiveDir, *retention, *artifactsDir)
+	if err != nil {
+		return err
+	}
+	if result.Archived == 0 {
+		fmt.Println("no closed beads older than the retention period; nothing archived")
+		return nil
+	}
+	fmt.Printf("archived %d beads to %s\n", result.Archived, result.ArchivePath)
+	if *artifactsDir != "" {
+		fmt.Printf("pruned %d artifact directories\n", result.PrunedDirs)
+		for id, err := range result.PruneErrs {
+			fmt.Printf("warning: failed to prune artifacts for %s: %v\n", id, err)
+		}
+	}
+	return nil
+}
+
+func resolveProvider(from, repo, baseURL, email string) (tracker.Provider, error) {
+	switch from {
+	case "github":
+		if repo == "" {
+			return nil, fmt.Errorf("-repo is required for --from github")
+		}
+		return tracker.GitHubProvider{Repo: repo, Token: os.Getenv("GITHUB_TOKEN"), HTTP: http.DefaultClient}, nil
+	case "jira":
+		if baseURL == "" || email == "" {
+			return nil, fmt.Errorf("-base-url and -email are required for --from jira")
+		}
+		return tracker.JiraProvider{BaseURL: baseURL, Email: email, Token: os.Getenv("JIRA_TOKEN"), HTTP: http.DefaultClient}, nil
+	default:
+		return nil, fmt.Errorf("unknown tracker %q (want github or jira)", from)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/gitops"
+)
+
+// blameBeadCommand implements `fire-flow blame-bead <path>`: for each
+// line of path, it reports the bead whose commit last touched it,
+// resolved via the Fire-Flow-Bead trailer (see gitops.BeadForCommit).
+type blameBeadCommand struct{}
+
+func (blameBeadCommand) Name() string     { return "blame-bead" }
+func (blameBeadCommand) Synopsis() string { return "show which bead last touched each line of a file" }
+
+func (blameBeadCommand) Run(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: fire-flow blame-bead <path>")
+	}
+	path := args[0]
+
+	cmd := exec.Command("git", "blame", "--line-porcelain", path)
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("running git blame on %s: %w", path, err)
+	}
+
+	beadBySHA := make(map[string]string)
+	lineNo := 0
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields[0]) == 40 && isHex(fields[0]) {
+			lineNo++
+			sha := fields[0]
+			bead, ok := beadBySHA[sha]
+			if !ok {
+				bead, err = gitops.BeadForCommit(".", sha)
+				if err != nil {
+					return err
+				}
+				if bead == "" {
+					bead = "(no bead)"
+				}
+				beadBySHA[sha] = bead
+			}
+			fmt.Printf("%d: %s %s\n", lineNo, sha[:8], bead)
+		}
+	}
+	return nil
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !((r >= '0' && r <= '9') || (r >= 'a' && r <= 'f')) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/bootstrap"
+)
+
+// bootstrapCommand implements `fire-flow bootstrap <template-repo>`: one
+// command to clone a template, seed its backlog, and wire the Kestra
+// flow that drives autonomous cycles.
+type bootstrapCommand struct{}
+
+func (bootstrapCommand) Name() string { return "bootstrap" }
+func (bootstrapCommand) Synopsis() string {
+	return "clone a template repo and wire it up for autonomous fire-flow cycles"
+}
+
+func (bootstrapCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("bootstrap", flag.ContinueOnError)
+	dest := fs.String("dest", "", "destination directory (default: derived from the template repo name)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(fs.Args()) != 1 {
+		return fmt.Errorf("usage: fire-flow bootstrap [-dest dir] <template-repo>")
+	}
+	templateURL := fs.Args()[0]
+	destDir := *dest
+	if destDir == "" {
+		destDir = repoNameFromURL(templateURL)
+	}
+
+	result, err := bootstrap.Bootstrap(bootstrap.Options{TemplateRepoURL: templateURL, DestDir: destDir})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("bootstrapped %s (preset: %s, %d bead(s) seeded)\n", result.DestDir, result.Preset, result.BeadsCreated)
+	return nil
+}
+
+// repoNameFromURL derives a destination directory name from a git URL's
+// final path segment, the same convention `git clone` itself uses when
+// no destination is given.
+func repoNameFromURL(url string) string {
+	name := url
+	for i := len(name) - 1; i >= 0; i-- {
+		if name[i] == '/' {
+			name = name[i+1:]
+			break
+		}
+	}
+	if len(name) > 4 && name[len(name)-4:] == ".git" {
+		name = name[:len(name)-4]
+	}
+	return name
+}
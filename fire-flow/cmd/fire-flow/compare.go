@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/testsession"
+)
+
+// compareCommand implements `fire-flow compare`, diffing two test
+// sessions saved by `fire-flow run-tests --label` — newly failing tests,
+// newly passing tests, and per-test duration deltas.
+type compareCommand struct{}
+
+func (compareCommand) Name() string     { return "compare" }
+func (compareCommand) Synopsis() string { return "diff two labeled test-run sessions" }
+
+func (compareCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("compare", flag.ContinueOnError)
+	dir := fs.String("dir", ".opencode/tcr/sessions", "directory labeled test sessions were saved in")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return fmt.Errorf("usage: fire-flow compare [-dir path] <before-label> <after-label>")
+	}
+
+	before, err := testsession.Load(*dir, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	after, err := testsession.Load(*dir, fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	comparison := testsession.Compare(before, after)
+	data, err := json.MarshalIndent(comparison, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling comparison: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/observability"
+)
+
+// digestCommand implements `fire-flow digest`, generating an ad hoc
+// activity report for a lookback window.
+type digestCommand struct{}
+
+func (digestCommand) Name() string     { return "digest" }
+func (digestCommand) Synopsis() string { return "summarize recent autonomous activity" }
+
+func (digestCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("digest", flag.ContinueOnError)
+	historyPath := fs.String("history", ".opencode/tcr/history.jsonl", "path to the history log")
+	since := fs.Duration("since", 24*time.Hour, "how far back to summarize")
+	slackWebhook := fs.String("slack-webhook", "", "optional Slack webhook URL to post the digest to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	digest, err := observability.BuildDigest(*historyPath, time.Now().Add(-*since))
+	if err != nil {
+		return err
+	}
+	fmt.Print(digest.Text())
+
+	if *slackWebhook != "" {
+		if err := observability.SendSlack(*slackWebhook, digest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/lprior-repo/fire-flow/internal/capability"
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+	"github.com/lprior-repo/fire-flow/internal/toolchain"
+)
+
+// doctorCommand implements `fire-flow doctor`, attempting a throwaway
+// overlay mount and reporting a diagnosed, remediation-bearing error
+// instead of a raw kernel errno when it fails, plus checking whether
+// this host's Go toolchain satisfies the repo's go.mod.
+type doctorCommand struct{}
+
+func (doctorCommand) Name() string     { return "doctor" }
+func (doctorCommand) Synopsis() string { return "diagnose why overlay mounts are failing on this host" }
+
+func (doctorCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	lower := fs.String("lower", "", "lower dir to test-mount (defaults to a scratch temp dir)")
+	goMod := fs.String("go-mod", "go.mod", "go.mod to check the declared toolchain against")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	checkToolchain(*goMod)
+	fmt.Println("capabilities:", capability.Detect())
+
+	lowerDir := *lower
+	if lowerDir == "" {
+		dir, err := os.MkdirTemp("", "fire-flow-doctor-lower")
+		if err != nil {
+			return fmt.Errorf("creating scratch lower dir: %w", err)
+		}
+		defer os.RemoveAll(dir)
+		lowerDir = dir
+	}
+
+	base, err := os.MkdirTemp("", "fire-flow-doctor")
+	if err != nil {
+		return fmt.Errorf("creating scratch dir: %w", err)
+	}
+	defer os.RemoveAll(base)
+
+	cfg := overlay.MountConfig{
+		Lower:  lowerDir,
+		Upper:  base + "/upper",
+		Work:   base + "/work",
+		Merged: base + "/merged",
+	}
+	session, err := overlay.NewMounter().Mount(cfg)
+	if err != nil {
+		var mountErr *overlay.MountError
+		if errors.As(err, &mountErr) {
+			fmt.Println(mountErr.UserFriendlyError())
+			return nil
+		}
+		return err
+	}
+	_ = session.Close()
+	fmt.Println("overlay mounts are working on this host")
+	return nil
+}
+
+// checkToolchain reports (but never fails the command over) a mismatch
+// between goMod's declared `go` directive and the toolchain actually on
+// PATH, so `doctor` surfaces it alongside overlay diagnostics instead of
+// letting it manifest later as a confusing test-behavior difference.
+func checkToolchain(goMod string) {
+	declared, err := toolchain.ReadGoMod(goMod)
+	if err != nil {
+		fmt.Printf("toolchain: skipping check (%v)\n", err)
+		return
+	}
+	actual, err := toolchain.ActualVersion(context.Background())
+	if err != nil {
+		fmt.Printf("toolchain: skipping check (%v)\n", err)
+		return
+	}
+	if mismatched, msg := toolchain.Mismatch(actual, declared); mismatched {
+		fmt.Println("toolchain mismatch:", msg)
+		return
+	}
+	fmt.Printf("toolchain: go%s satisfies %s\n", actual, goMod)
+}
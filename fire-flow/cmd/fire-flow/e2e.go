@@ -0,0 +1,189 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/config"
+	"github.com/lprior-repo/fire-flow/internal/gitops"
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+	"github.com/lprior-repo/fire-flow/internal/shell"
+)
+
+// e2eCommand implements `fire-flow e2e`, running one full TCR cycle
+// (mount, edit, test, commit) against a scratch git repo built entirely
+// in a temp dir, using overlay.FakeMounter so the whole demo works on
+// any OS and needs no privileges — useful for CI smoke tests and
+// onboarding demos where a real overlay mount isn't available.
+type e2eCommand struct{}
+
+func (e2eCommand) Name() string { return "e2e" }
+func (e2eCommand) Synopsis() string {
+	return "run a full TCR cycle against a scratch repo with no privileges required"
+}
+
+func (e2eCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("e2e", flag.ContinueOnError)
+	backend := fs.String("backend", "fake", `execution backend for the demo cycle; only "fake" (overlay.FakeMounter) is implemented today`)
+	testCmd := fs.String("test-cmd", "", "shell-quoted test command to run inside the edited tree; defaults to a canned check requiring no external tool")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *backend != "fake" {
+		return fmt.Errorf("unsupported --backend %q (only \"fake\" is implemented)", *backend)
+	}
+	return runFakeCycle(*testCmd)
+}
+
+// runFakeCycle builds a one-file scratch repo with a RED starting state,
+// mounts it via FakeMounter, "edits" the file to make it pass, runs the
+// test, and commits (or reports a revert) exactly like a real cycle
+// would against the real overlay driver.
+func runFakeCycle(testCmd string) error {
+	root, err := os.MkdirTemp("", "fire-flow-e2e")
+	if err != nil {
+		return fmt.Errorf("creating scratch root: %w", err)
+	}
+	defer os.RemoveAll(root)
+
+	lower := filepath.Join(root, "repo")
+	if err := os.MkdirAll(lower, 0o755); err != nil {
+		return fmt.Errorf("creating scratch repo dir: %w", err)
+	}
+	if err := seedScratchRepo(lower); err != nil {
+		return err
+	}
+
+	cfg := overlay.MountConfig{
+		Lower:  lower,
+		Upper:  filepath.Join(root, "upper"),
+		Work:   filepath.Join(root, "work"),
+		Merged: filepath.Join(root, "merged"),
+	}
+	session, err := overlay.NewFakeMounter().Mount(cfg)
+	if err != nil {
+		return fmt.Errorf("mounting fake overlay: %w", err)
+	}
+	defer session.Close()
+	fmt.Printf("mounted fake overlay at %s\n", cfg.Merged)
+
+	if err := applyDemoEdit(cfg.Merged); err != nil {
+		return err
+	}
+
+	passed, err := runDemoTest(cfg.Merged, testCmd)
+	if err != nil {
+		return err
+	}
+	if !passed {
+		fmt.Println("RED: test failed, reverting (nothing committed)")
+		return nil
+	}
+	fmt.Println("GREEN: test passed")
+
+	if err := syncFile(cfg.Merged, lower, "greeting.go"); err != nil {
+		return err
+	}
+	author := config.Author{Name: "fire-flow e2e", Email: "fire-flow-e2e@localhost"}
+	if err := gitops.CommitAs(lower, author, "e2e: apply demo edit", "e2e-demo"); err != nil {
+		return fmt.Errorf("committing to scratch repo: %w", err)
+	}
+	fmt.Println("committed to scratch repo at", lower)
+	return nil
+}
+
+const greetingBefore = `package greeting
+
+func Message() string {
+	return "old"
+}
+`
+
+const greetingTest = `package greeting
+
+import "testing"
+
+func TestMessage(t *testing.T) {
+	if Message() != "new" {
+		t.Fatalf("Message() = %q, want %q", Message(), "new")
+	}
+}
+`
+
+// seedScratchRepo git-inits lower and commits a source file whose test
+// starts RED (Message returns "old" but the test wants "new").
+func seedScratchRepo(lower string) error {
+	if err := runGit(lower, "init", "-q"); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(lower, "greeting.go"), []byte(greetingBefore), 0o644); err != nil {
+		return fmt.Errorf("writing seed source: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(lower, "greeting_test.go"), []byte(greetingTest), 0o644); err != nil {
+		return fmt.Errorf("writing seed test: %w", err)
+	}
+	author := config.Author{Name: "fire-flow e2e", Email: "fire-flow-e2e@localhost"}
+	return gitops.CommitAs(lower, author, "e2e: seed RED baseline", "e2e-demo")
+}
+
+// applyDemoEdit stands in for an AI's edit: it flips Message's return
+// value so the seeded test goes GREEN.
+func applyDemoEdit(mergedDir string) error {
+	path := filepath.Join(mergedDir, "greeting.go")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading merged source: %w", err)
+	}
+	fixed := strings.Replace(string(data), `"old"`, `"new"`, 1)
+	if err := os.WriteFile(path, []byte(fixed), 0o644); err != nil {
+		return fmt.Errorf("writing edit: %w", err)
+	}
+	return nil
+}
+
+// runDemoTest runs testCmd inside mergedDir if given, else falls back to
+// a canned check of greeting.go's content, so the demo works even on a
+// host without a Go toolchain installed.
+func runDemoTest(mergedDir, testCmd string) (bool, error) {
+	if testCmd == "" {
+		data, err := os.ReadFile(filepath.Join(mergedDir, "greeting.go"))
+		if err != nil {
+			return false, fmt.Errorf("reading edited source for canned check: %w", err)
+		}
+		return strings.Contains(string(data), `"new"`), nil
+	}
+	argv, err := shell.Split(testCmd)
+	if err != nil {
+		return false, fmt.Errorf("parsing -test-cmd: %w", err)
+	}
+	if len(argv) == 0 {
+		return false, fmt.Errorf("-test-cmd parsed to no arguments")
+	}
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Dir = mergedDir
+	return cmd.Run() == nil, nil
+}
+
+func syncFile(mergedDir, lowerDir, rel string) error {
+	data, err := os.ReadFile(filepath.Join(mergedDir, rel))
+	if err != nil {
+		return fmt.Errorf("reading edited %s: %w", rel, err)
+	}
+	if err := os.WriteFile(filepath.Join(lowerDir, rel), data, 0o644); err != nil {
+		return fmt.Errorf("writing %s to scratch repo: %w", rel, err)
+	}
+	return nil
+}
+
+func runGit(dir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+	"github.com/lprior-repo/fire-flow/internal/epic"
+	"github.com/lprior-repo/fire-flow/internal/shell"
+)
+
+// epicCommand implements `fire-flow epic run <epic-id>`, running every
+// bead under an epic in dependency order and printing a consolidated
+// per-bead report.
+type epicCommand struct{}
+
+func (epicCommand) Name() string     { return "epic" }
+func (epicCommand) Synopsis() string { return "batch-run every bead under an epic" }
+
+func (epicCommand) Run(args []string) error {
+	if len(args) == 0 || args[0] != "run" {
+		return fmt.Errorf("usage: fire-flow epic run [flags] <epic-id>")
+	}
+	rest := args[1:]
+	fs := flag.NewFlagSet("epic run", flag.ContinueOnError)
+	beadsPath := fs.String("beads-file", ".beads/issues.jsonl", "beads JSONL file")
+	workers := fs.Int("workers", 1, "beads within the same dependency level to run concurrently")
+	maxFailures := fs.Int("max-failures", 0, "stop the run once more than this many beads have failed (0 means no limit)")
+	beadCmd := fs.String("bead-cmd", "", `shell-quoted command run per bead, with {bead_id} substituted, e.g. "fire-flow mutate -bead {bead_id}"`)
+	if err := fs.Parse(rest); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: fire-flow epic run [flags] <epic-id>")
+	}
+	epicID := fs.Arg(0)
+	if *beadCmd == "" {
+		return fmt.Errorf("epic run: --bead-cmd is required (no built-in single-bead runner exists yet)")
+	}
+
+	issues, err := bead.ReadAll(*beadsPath)
+	if err != nil {
+		return err
+	}
+
+	runFunc := func(issue bead.Issue) error {
+		return runBeadCommand(*beadCmd, issue.ID)
+	}
+	report, err := epic.Run(issues, epicID, runFunc, epic.Options{Workers: *workers, MaxFailures: *maxFailures})
+	if err != nil {
+		return err
+	}
+
+	for _, o := range report.Outcomes {
+		if o.Err != nil {
+			fmt.Printf("FAIL %s (%s): %v\n", o.BeadID, o.Duration, o.Err)
+		} else {
+			fmt.Printf("OK   %s (%s)\n", o.BeadID, o.Duration)
+		}
+	}
+	fmt.Printf("epic %s: %d/%d beads failed\n", epicID, report.FailureCount(), len(report.Outcomes))
+	if report.FailureCount() > 0 {
+		os.Exit(exitGeneric)
+	}
+	return nil
+}
+
+func runBeadCommand(template, beadID string) error {
+	command := strings.ReplaceAll(template, "{bead_id}", beadID)
+	argv, err := shell.Split(command)
+	if err != nil {
+		return fmt.Errorf("parsing --bead-cmd: %w", err)
+	}
+	if len(argv) == 0 {
+		return fmt.Errorf("--bead-cmd parsed to no arguments")
+	}
+	cmd := exec.Command(argv[0], argv[1:]...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
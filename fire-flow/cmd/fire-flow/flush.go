@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/gitops"
+)
+
+// flushCommand implements `fire-flow flush`, pushing every bead queued
+// during quiet hours (see config.QuietHours).
+type flushCommand struct{}
+
+func (flushCommand) Name() string     { return "flush" }
+func (flushCommand) Synopsis() string { return "push beads queued during quiet hours" }
+
+func (flushCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("flush", flag.ContinueOnError)
+	repoDir := fs.String("repo", ".", "repository directory")
+	queuePath := fs.String("queue", ".opencode/tcr/push-queue.txt", "path to the push queue file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	q := gitops.NewPushQueue(*queuePath)
+	results, err := gitops.Flush(*repoDir, q)
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		fmt.Printf("pushed %s\n", r.Branch)
+	}
+	fmt.Printf("flushed %d bead(s)\n", len(results))
+	return nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+	"github.com/lprior-repo/fire-flow/internal/forecast"
+	"github.com/lprior-repo/fire-flow/internal/history"
+)
+
+// forecastCommand implements `fire-flow forecast`, estimating completion
+// time for the remaining backlog from observed throughput.
+type forecastCommand struct{}
+
+func (forecastCommand) Name() string { return "forecast" }
+func (forecastCommand) Synopsis() string {
+	return "estimate completion time for the remaining backlog"
+}
+
+func (forecastCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("forecast", flag.ContinueOnError)
+	historyPath := fs.String("history", ".opencode/tcr/history.jsonl", "path to the cycle history log")
+	beadsPath := fs.String("beads-file", ".beads/issues.jsonl", "beads JSONL file")
+	workers := fs.Int("workers", 1, "number of workers processing the backlog in parallel")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	page, err := history.Read(*historyPath, history.Query{})
+	if err != nil {
+		return err
+	}
+	throughput, err := forecast.ComputeThroughput(page.Entries)
+	if err != nil {
+		return err
+	}
+	if throughput.BeadsCompleted == 0 {
+		return fmt.Errorf("no completed beads in %s yet; nothing to forecast from", *historyPath)
+	}
+
+	issues, err := bead.ReadAll(*beadsPath)
+	if err != nil {
+		return err
+	}
+	remaining := 0
+	for _, issue := range issues {
+		if issue.Status == "open" {
+			remaining++
+		}
+	}
+
+	result := forecast.Complete(throughput, remaining, *workers, time.Now())
+	return json.NewEncoder(os.Stdout).Encode(result)
+}
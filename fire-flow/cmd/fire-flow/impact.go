@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/lprior-repo/fire-flow/internal/impact"
+)
+
+// impactCommand implements `fire-flow impact`, exporting the change ->
+// test mapping for a commit range so an external CI pipeline can mirror
+// fire-flow's targeted test strategy.
+type impactCommand struct{}
+
+func (impactCommand) Name() string     { return "impact" }
+func (impactCommand) Synopsis() string { return "export the change->test mapping for a commit range" }
+
+func (impactCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("impact", flag.ContinueOnError)
+	repoDir := fs.String("repo", ".", "repository directory")
+	from := fs.String("from", "", "start of the commit range")
+	to := fs.String("to", "HEAD", "end of the commit range")
+	asJSON := fs.Bool("json", false, "emit JSON instead of a plain-text summary")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" {
+		return fmt.Errorf("usage: fire-flow impact -from <sha> [-to <sha>] [-json]")
+	}
+
+	result, err := impact.Analyze(*repoDir, *from, *to)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		return json.NewEncoder(os.Stdout).Encode(result)
+	}
+	fmt.Printf("%d file(s) changed, %d package(s) affected, %d test(s) affected\n",
+		len(result.ChangedFiles), len(result.AffectedPackages), len(result.AffectedTests))
+	for _, t := range result.AffectedTests {
+		fmt.Println("  " + t)
+	}
+	return nil
+}
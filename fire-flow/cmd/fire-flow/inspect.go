@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+)
+
+// inspectCommand implements `fire-flow inspect <lower>`, mounting a
+// candidate merged view read-only so a reviewer or tool can browse it
+// with no possibility of modifying the source tree.
+type inspectCommand struct{}
+
+func (inspectCommand) Name() string     { return "inspect" }
+func (inspectCommand) Synopsis() string { return "mount a read-only view of a session for review" }
+
+func (inspectCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	merged := fs.String("merged", "", "where to mount the read-only view")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || *merged == "" {
+		return fmt.Errorf("usage: fire-flow inspect -merged <dir> <lower-dir>")
+	}
+
+	session, err := overlay.Inspect(overlay.NewMounter(), overlay.MountConfig{Lower: fs.Arg(0), Merged: *merged})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("mounted read-only view at %s\n", session.Config.Merged)
+	return nil
+}
@@ -0,0 +1,102 @@
+// Command fire-flow is the TCR/mutation-testing enforcer CLI: it runs the
+// test-driven-development gate, the test && commit || revert loop, and
+// mutation testing described in FIRE-FLOW_PLAN.md and the Fire-Flow-11f
+// bead epic.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lprior-repo/fire-flow/internal/cli"
+	"github.com/lprior-repo/fire-flow/internal/config"
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+	"github.com/lprior-repo/fire-flow/internal/state"
+)
+
+// Exit codes for command errors, distinct from internal/cycle's cycle-
+// outcome codes: these classify *why a command itself failed to run*,
+// so scripts wrapping fire-flow can branch without parsing stderr.
+const (
+	exitGeneric      = 1
+	exitUnknownUsage = 2
+	exitMountFailure = 4
+	exitStateCorrupt = 5
+	exitBadConfig    = 6
+)
+
+// exitCodeFor maps a command error to its process exit code by walking
+// the error chain with errors.As, so the typed errors overlay/state/
+// config export are the single source of truth for both this mapping
+// and any caller that wants to branch on the same error itself.
+func exitCodeFor(err error) int {
+	var mountErr *overlay.MountError
+	if errors.As(err, &mountErr) {
+		return exitMountFailure
+	}
+	var corruptErr *state.CorruptError
+	if errors.As(err, &corruptErr) {
+		return exitStateCorrupt
+	}
+	var parseErr *config.ParseError
+	if errors.As(err, &parseErr) {
+		return exitBadConfig
+	}
+	return exitGeneric
+}
+
+func commands() []cli.Command {
+	return []cli.Command{
+		mutateCommand{},
+		refreshCommand{},
+		stateCommand{},
+		blameBeadCommand{},
+		provisionCommand{},
+		pauseCommand{},
+		resumeCommand{},
+		flushCommand{},
+		pingCommand{},
+		serviceCommand{},
+		inspectCommand{},
+		benchCommand{},
+		digestCommand{},
+		reviewCommand{},
+		impactCommand{},
+		beadsCommand{},
+		bootstrapCommand{},
+		forecastCommand{},
+		replayCommand{},
+		doctorCommand{},
+		runTestsCommand{},
+		compareCommand{},
+		e2eCommand{},
+		orgSyncCommand{},
+		epicCommand{},
+		tailCommand{},
+		serveCommand{},
+		askpassCommand{},
+		attestCommand{},
+		runCommand{},
+		nextBeadCommand{},
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: fire-flow <command> [args]")
+		os.Exit(exitUnknownUsage)
+	}
+	name := os.Args[1]
+	for _, c := range commands() {
+		if c.Name() == name {
+			if err := c.Run(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "fire-flow %s: %v\n", name, err)
+				os.Exit(exitCodeFor(err))
+			}
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "fire-flow: unknown command %q\n", name)
+	os.Exit(exitUnknownUsage)
+}
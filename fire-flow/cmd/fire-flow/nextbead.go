@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+	"github.com/lprior-repo/fire-flow/internal/history"
+	"github.com/lprior-repo/fire-flow/internal/scheduler"
+)
+
+// nextBeadCommand implements `fire-flow next-bead`, picking which open
+// bead to run next (and whether the one currently stuck has earned
+// escalation) via scheduler.Strategy. The built-in strategy runs by
+// default; -plugin or -wasm swaps in a custom prioritization scheme
+// without forking fire-flow.
+type nextBeadCommand struct{}
+
+func (nextBeadCommand) Name() string     { return "next-bead" }
+func (nextBeadCommand) Synopsis() string { return "pick the next bead to run via the scheduler strategy" }
+
+// nextBeadResult is next-bead's JSON output.
+type nextBeadResult struct {
+	BeadID    string `json:"bead_id,omitempty"`
+	Escalate  bool   `json:"escalate"`
+	NoneReady bool   `json:"none_ready,omitempty"`
+}
+
+func (nextBeadCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("next-bead", flag.ContinueOnError)
+	beadsPath := fs.String("beads-file", ".beads/issues.jsonl", "beads JSONL file")
+	historyPath := fs.String("history", ".opencode/tcr/history.jsonl", "path to the cycle history log, used to compute each bead's revert streak")
+	pluginPath := fs.String("plugin", "", "path to a Go plugin (.so) exporting a Strategy symbol; mutually exclusive with -wasm")
+	wasmPath := fs.String("wasm", "", "path to a WASM scheduler policy module; mutually exclusive with -plugin")
+	maxRevertStreak := fs.Int("max-revert-streak", 3, "consecutive reverts before escalating (default strategy only)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *pluginPath != "" && *wasmPath != "" {
+		return fmt.Errorf("-plugin and -wasm are mutually exclusive")
+	}
+
+	issues, err := bead.ReadAll(*beadsPath)
+	if err != nil {
+		return err
+	}
+	page, err := history.Read(*historyPath, history.Query{})
+	if err != nil {
+		return err
+	}
+
+	candidates := buildCandidates(issues, page.Entries)
+
+	strategy, closeStrategy, err := resolveStrategy(*pluginPath, *wasmPath, *maxRevertStreak)
+	if err != nil {
+		return err
+	}
+	if closeStrategy != nil {
+		defer closeStrategy()
+	}
+
+	idx := strategy.Next(candidates)
+	if idx < 0 {
+		return json.NewEncoder(os.Stdout).Encode(nextBeadResult{NoneReady: true})
+	}
+	chosen := candidates[idx]
+	return json.NewEncoder(os.Stdout).Encode(nextBeadResult{
+		BeadID:   chosen.Issue.ID,
+		Escalate: strategy.ShouldEscalate(chosen),
+	})
+}
+
+// resolveStrategy builds the scheduler.Strategy named by pluginPath or
+// wasmPath, falling back to scheduler.DefaultStrategy when neither is
+// set. The returned close func releases a WASM strategy's runtime; it's
+// nil for the default and Go-plugin strategies, which own no resources.
+func resolveStrategy(pluginPath, wasmPath string, maxRevertStreak int) (scheduler.Strategy, func(), error) {
+	switch {
+	case pluginPath != "":
+		strat, err := scheduler.LoadGoPlugin(pluginPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		return strat, nil, nil
+	case wasmPath != "":
+		wasmBytes, err := os.ReadFile(wasmPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading -wasm %s: %w", wasmPath, err)
+		}
+		ctx := context.Background()
+		strat, err := scheduler.LoadWASMStrategy(ctx, wasmBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return strat, func() { _ = strat.Close(ctx) }, nil
+	default:
+		return scheduler.DefaultStrategy{MaxRevertStreak: maxRevertStreak}, nil, nil
+	}
+}
+
+// buildCandidates turns every open issue into a scheduler.Candidate,
+// deriving RevertStreak from how many of the bead's most recent history
+// entries in a row were reverts, and AgeSeconds from CreatedAt.
+func buildCandidates(issues []bead.Issue, entries []cycle.Summary) []scheduler.Candidate {
+	now := time.Now()
+	var candidates []scheduler.Candidate
+	for _, issue := range issues {
+		if issue.Status != "open" {
+			continue
+		}
+		age := int64(0)
+		if created, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
+			age = int64(now.Sub(created).Seconds())
+		}
+		candidates = append(candidates, scheduler.Candidate{
+			Issue:        issue,
+			RevertStreak: revertStreak(entries, issue.ID),
+			AgeSeconds:   age,
+		})
+	}
+	return candidates
+}
+
+// revertStreak counts beadID's most recent consecutive DecisionReverted
+// entries, scanning backward from the end of the (oldest-first) history
+// log and stopping at the first non-revert, so a subsequent green run
+// resets the streak.
+func revertStreak(entries []cycle.Summary, beadID string) int {
+	streak := 0
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].BeadID != beadID {
+			continue
+		}
+		if entries[i].Decision != cycle.DecisionReverted {
+			break
+		}
+		streak++
+	}
+	return streak
+}
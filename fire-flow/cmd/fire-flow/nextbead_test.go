@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+)
+
+// captureStdout redirects os.Stdout for the duration of fn and returns
+// what it wrote, since nextBeadCommand reports its result by encoding
+// JSON straight to os.Stdout rather than returning it.
+func captureStdout(t *testing.T, fn func()) []byte {
+	t.Helper()
+	orig := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("creating stdout pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	fn()
+
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading captured stdout: %v", err)
+	}
+	return bytes.TrimSpace(out)
+}
+
+func writeTestBeads(t *testing.T, path string, issues ...bead.Issue) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("creating beads file: %v", err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for _, i := range issues {
+		if err := enc.Encode(i); err != nil {
+			t.Fatalf("encoding bead: %v", err)
+		}
+	}
+}
+
+func TestNextBeadCommandPicksHighestPriorityOpenBead(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, "issues.jsonl")
+	writeTestBeads(t, beadsPath,
+		bead.Issue{ID: "low", Status: "open", Priority: 1},
+		bead.Issue{ID: "high", Status: "open", Priority: 5},
+		bead.Issue{ID: "closed", Status: "closed", Priority: 9},
+	)
+
+	stdout := captureStdout(t, func() {
+		if err := (nextBeadCommand{}).Run([]string{
+			"-beads-file", beadsPath,
+			"-history", filepath.Join(dir, "history.jsonl"),
+		}); err != nil {
+			t.Fatalf("next-bead: %v", err)
+		}
+	})
+
+	var result nextBeadResult
+	if err := json.Unmarshal(stdout, &result); err != nil {
+		t.Fatalf("parsing next-bead output %q: %v", stdout, err)
+	}
+	if result.BeadID != "high" {
+		t.Fatalf("next-bead picked %q, want the higher-priority open bead %q", result.BeadID, "high")
+	}
+}
+
+func TestNextBeadCommandNoneReadyWhenNothingOpen(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, "issues.jsonl")
+	writeTestBeads(t, beadsPath, bead.Issue{ID: "done", Status: "closed"})
+
+	stdout := captureStdout(t, func() {
+		if err := (nextBeadCommand{}).Run([]string{
+			"-beads-file", beadsPath,
+			"-history", filepath.Join(dir, "history.jsonl"),
+		}); err != nil {
+			t.Fatalf("next-bead: %v", err)
+		}
+	})
+
+	var result nextBeadResult
+	if err := json.Unmarshal(stdout, &result); err != nil {
+		t.Fatalf("parsing next-bead output %q: %v", stdout, err)
+	}
+	if !result.NoneReady {
+		t.Fatalf("next-bead result = %+v, want none_ready with no open beads", result)
+	}
+}
+
+func TestNextBeadCommandEscalatesAfterRevertStreak(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, "issues.jsonl")
+	writeTestBeads(t, beadsPath, bead.Issue{ID: "flaky", Status: "open", Priority: 1})
+
+	historyPath := filepath.Join(dir, "history.jsonl")
+	f, err := os.Create(historyPath)
+	if err != nil {
+		t.Fatalf("creating history file: %v", err)
+	}
+	enc := json.NewEncoder(f)
+	for i := 0; i < 3; i++ {
+		if err := enc.Encode(cycle.Summary{BeadID: "flaky", Decision: cycle.DecisionReverted}); err != nil {
+			t.Fatalf("encoding history entry: %v", err)
+		}
+	}
+	f.Close()
+
+	stdout := captureStdout(t, func() {
+		if err := (nextBeadCommand{}).Run([]string{
+			"-beads-file", beadsPath,
+			"-history", historyPath,
+			"-max-revert-streak", "3",
+		}); err != nil {
+			t.Fatalf("next-bead: %v", err)
+		}
+	})
+
+	var result nextBeadResult
+	if err := json.Unmarshal(stdout, &result); err != nil {
+		t.Fatalf("parsing next-bead output %q: %v", stdout, err)
+	}
+	if !result.Escalate {
+		t.Fatalf("next-bead result = %+v, want escalate=true after 3 reverts", result)
+	}
+}
+
+func TestNextBeadCommandRejectsBothPluginAndWasm(t *testing.T) {
+	dir := t.TempDir()
+	err := (nextBeadCommand{}).Run([]string{
+		"-beads-file", filepath.Join(dir, "issues.jsonl"),
+		"-history", filepath.Join(dir, "history.jsonl"),
+		"-plugin", "foo.so",
+		"-wasm", "foo.wasm",
+	})
+	if err == nil {
+		t.Fatal("next-bead with both -plugin and -wasm should fail")
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/orgpolicy"
+)
+
+// orgSyncCommand implements `fire-flow org-sync`, fetching an
+// organization-level defaults repository (shared prompts, policies,
+// presets) and reporting which config paths the project overrides
+// itself versus inherits from the org repo.
+type orgSyncCommand struct{}
+
+func (orgSyncCommand) Name() string     { return "org-sync" }
+func (orgSyncCommand) Synopsis() string { return "sync org-level default prompts/policies and report inheritance" }
+
+func (orgSyncCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("org-sync", flag.ContinueOnError)
+	repoURL := fs.String("repo", "", "org defaults repository URL")
+	ref := fs.String("ref", "main", "git ref to pin the org defaults to")
+	destDir := fs.String("dest", ".opencode/tcr/org-defaults", "local clone destination for the org defaults repo")
+	projectDir := fs.String("project-dir", ".", "project directory whose own config takes precedence over the org defaults")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *repoURL == "" {
+		return fmt.Errorf("org-sync: --repo is required")
+	}
+
+	src := orgpolicy.Source{RepoURL: *repoURL, Ref: *ref, DestDir: *destDir}
+	if err := orgpolicy.Sync(src); err != nil {
+		return fmt.Errorf("org-sync: %w", err)
+	}
+	fmt.Printf("synced org defaults from %s@%s into %s\n", *repoURL, *ref, *destDir)
+
+	sources, err := orgpolicy.Layer(*destDir, *projectDir)
+	if err != nil {
+		return fmt.Errorf("org-sync: layering config: %w", err)
+	}
+	orgCount, projectCount := 0, 0
+	for _, from := range sources {
+		if from == "org" {
+			orgCount++
+		} else {
+			projectCount++
+		}
+	}
+	fmt.Printf("%d config paths inherited from org, %d overridden by project\n", orgCount, projectCount)
+	return nil
+}
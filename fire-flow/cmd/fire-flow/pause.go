@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/state"
+)
+
+// pauseCommand implements `fire-flow pause`: suspends automatic
+// test/commit/revert cycles while leaving the overlay mounted, so a
+// manual refactor in progress isn't reverted by the loop.
+type pauseCommand struct{}
+
+func (pauseCommand) Name() string     { return "pause" }
+func (pauseCommand) Synopsis() string { return "suspend automatic TCR cycles" }
+
+func (pauseCommand) Run(args []string) error { return setPaused(args, true) }
+
+// resumeCommand implements `fire-flow resume`, the inverse of pause.
+type resumeCommand struct{}
+
+func (resumeCommand) Name() string     { return "resume" }
+func (resumeCommand) Synopsis() string { return "resume automatic TCR cycles after a pause" }
+
+func (resumeCommand) Run(args []string) error { return setPaused(args, false) }
+
+func setPaused(args []string, paused bool) error {
+	fs := flag.NewFlagSet("pause", flag.ContinueOnError)
+	path := fs.String("state", "state.json", "path to state.json")
+	maxBackups := fs.Int("max-backups", 5, "rotated backups to keep")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	store := state.NewStore(*path, *maxBackups)
+	st, err := store.SetPaused(paused)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("paused=%v mode=%s\n", st.Paused, st.Mode)
+	return nil
+}
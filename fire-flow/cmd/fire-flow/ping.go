@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// pingCommand implements `fire-flow ping`, a thin CLI wrapper around the
+// daemon's /healthz endpoint for use in shell scripts and cron checks.
+type pingCommand struct{}
+
+func (pingCommand) Name() string     { return "ping" }
+func (pingCommand) Synopsis() string { return "check whether the fire-flow daemon is alive" }
+
+func (pingCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("ping", flag.ContinueOnError)
+	url := fs.String("url", "http://localhost:8080/healthz", "daemon health endpoint")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	resp, err := http.Get(*url)
+	if err != nil {
+		return fmt.Errorf("pinging %s: %w", *url, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("daemon unhealthy: %s returned %d: %s", *url, resp.StatusCode, body)
+	}
+	fmt.Println("ok")
+	return nil
+}
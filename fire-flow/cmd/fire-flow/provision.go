@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/provision"
+	"github.com/lprior-repo/fire-flow/internal/shell"
+)
+
+// provisionCommand implements `fire-flow provision`, setting up a fresh
+// worker host with a fast clone and the .opencode/tcr layout.
+type provisionCommand struct{}
+
+func (provisionCommand) Name() string { return "provision" }
+func (provisionCommand) Synopsis() string {
+	return "shallow/blobless clone plus sparse-checkout for a fresh worker host"
+}
+
+func (provisionCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("provision", flag.ContinueOnError)
+	repoURL := fs.String("repo", "", "repository URL to clone")
+	dest := fs.String("dest", "", "destination directory")
+	module := fs.String("module", "", "sparse-checkout scope (repo-relative path); empty clones everything")
+	depth := fs.Int("depth", 0, "clone depth; 0 uses a blobless clone instead")
+	branch := fs.String("branch", "main", "branch to check out")
+	baselineCmd := fs.String("baseline-cmd", "", "test command (e.g. \"go test -json ./...\") to run once and record as the RED baseline; empty skips this")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *repoURL == "" || *dest == "" {
+		return fmt.Errorf("usage: fire-flow provision -repo <url> -dest <dir> [-module <path>] [-depth <n>] [-branch <name>] [-baseline-cmd <cmd>]")
+	}
+
+	var baselineArgv []string
+	if *baselineCmd != "" {
+		argv, err := shell.Split(*baselineCmd)
+		if err != nil {
+			return fmt.Errorf("parsing -baseline-cmd: %w", err)
+		}
+		baselineArgv = argv
+	}
+
+	result, err := provision.Provision(provision.Options{
+		RepoURL:             *repoURL,
+		DestDir:             *dest,
+		Module:              *module,
+		Depth:               *depth,
+		MainBranch:          *branch,
+		BaselineTestCommand: baselineArgv,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("provisioned %s (blobless=%v sparse=%v baseline=%v)\n", result.DestDir, result.Blobless, result.Sparse, result.BaselineRecorded)
+	return nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+)
+
+// refreshCommand implements `fire-flow refresh`, syncing an overlay
+// session's lower dir with its upstream and reporting overlaps with the
+// session's own changes.
+type refreshCommand struct{}
+
+func (refreshCommand) Name() string { return "refresh" }
+func (refreshCommand) Synopsis() string {
+	return "pull the lower dir's upstream and report overlaps with the current session"
+}
+
+func (refreshCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("refresh", flag.ContinueOnError)
+	lowerDir := fs.String("lower", ".", "path to the overlay's lower dir (the real repo)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	result, err := overlay.Refresh(*lowerDir, fs.Args())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("pulled %d commit(s) into lower dir\n", result.LowerCommitsPulled)
+	if len(result.Overlapping) > 0 {
+		fmt.Println("overlapping with session changes:")
+		for _, f := range result.Overlapping {
+			fmt.Printf("  %s\n", f)
+		}
+	}
+	return nil
+}
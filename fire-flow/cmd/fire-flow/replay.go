@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/replay"
+)
+
+// replayCommand implements `fire-flow replay`, re-executing a recorded
+// cycle's gate decision for debugging without rerunning tests or the AI.
+type replayCommand struct{}
+
+func (replayCommand) Name() string { return "replay" }
+func (replayCommand) Synopsis() string {
+	return "re-run a recorded cycle's gate decision against its saved inputs"
+}
+
+func (replayCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	dir := fs.String("dir", ".opencode/tcr/replays", "directory holding recorded cycle inputs")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: fire-flow replay [-dir path] <cycle-id>")
+	}
+	cycleID := fs.Arg(0)
+
+	record, err := replay.Load(*dir, cycleID)
+	if err != nil {
+		return err
+	}
+	result, err := replay.Run(context.Background(), record)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling replay result: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
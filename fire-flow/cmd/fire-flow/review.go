@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+
+	"github.com/lprior-repo/fire-flow/internal/review"
+)
+
+// reviewCommand implements `fire-flow review`, serving a local web UI
+// for approving or rejecting a pending overlay session instead of
+// requiring a pushed branch and PR just to look at the diff.
+type reviewCommand struct{}
+
+func (reviewCommand) Name() string     { return "review" }
+func (reviewCommand) Synopsis() string { return "serve a local diff-review UI for pending sessions" }
+
+func (reviewCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("review", flag.ContinueOnError)
+	addr := fs.String("addr", "127.0.0.1:8787", "address to serve the review UI on")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	server, err := review.NewServer()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("serving review UI on http://%s\n", *addr)
+	return http.ListenAndServe(*addr, server.Handler())
+}
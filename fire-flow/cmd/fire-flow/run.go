@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/execbackend"
+)
+
+// runCommand implements `fire-flow run`, executing an AI or test command
+// against -dir through a configurable execbackend.Backend: on the host,
+// inside a container for dependency isolation, or on a remote host over
+// SSH (syncing -dir there and back with rsync) for platforms that can't
+// drive the overlay workflow directly.
+type runCommand struct{}
+
+func (runCommand) Name() string { return "run" }
+func (runCommand) Synopsis() string {
+	return "run a command against a project dir through a configurable execution backend"
+}
+
+func (runCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	dir := fs.String("dir", ".", "working directory the command runs against, e.g. the overlay's merged dir")
+	backend := fs.String("backend", "local", `execution backend: "local", "container", or "remote"`)
+	image := fs.String("image", "", "container image (required when -backend=container)")
+	tool := fs.String("tool", "docker", `container tool: "docker" or "podman"`)
+	remoteHost := fs.String("remote-host", "", "user@host to run on (required when -backend=remote)")
+	remoteDir := fs.String("remote-dir", "", "working directory on the remote host (required when -backend=remote)")
+	identityFile := fs.String("identity-file", "", "optional ssh/rsync -i identity file for -backend=remote")
+	sync := fs.Bool("sync", true, "for -backend=remote, rsync -dir to the remote host before running and back after")
+	nixKind := fs.String("nix-kind", "", `activate a "flake" or "devenv" shell around the command before running it; empty disables activation`)
+	nixProjectDir := fs.String("nix-project-dir", "", "project dir containing flake.nix/devenv.nix (defaults to -dir)")
+	nixCacheDir := fs.String("nix-cache-dir", ".opencode/tcr/nix-cache", "directory the evaluated nix/devenv environment is cached under")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(fs.Args()) == 0 {
+		return fmt.Errorf("usage: fire-flow run [-dir path] [-backend local|container|remote] [-nix-kind flake|devenv] -- <command> [args...]")
+	}
+	if *backend == "remote" && (*remoteHost == "" || *remoteDir == "") {
+		return fmt.Errorf("-backend=remote requires -remote-host and -remote-dir")
+	}
+	command, cmdArgs := fs.Args()[0], fs.Args()[1:]
+
+	ctx := context.Background()
+	if *nixKind != "" {
+		projectDir := *nixProjectDir
+		if projectDir == "" {
+			projectDir = *dir
+		}
+		env := execbackend.NixEnv{Kind: *nixKind, ProjectDir: projectDir, CacheDir: *nixCacheDir}
+		if _, err := env.EnsureCached(ctx); err != nil {
+			return fmt.Errorf("activating %s environment: %w", *nixKind, err)
+		}
+		command, cmdArgs = env.Wrap(command, cmdArgs)
+	}
+
+	b := execbackend.New(execbackend.Config{
+		Kind:         *backend,
+		Image:        *image,
+		Tool:         *tool,
+		Host:         *remoteHost,
+		RemoteDir:    *remoteDir,
+		IdentityFile: *identityFile,
+	})
+
+	if remote, ok := b.(execbackend.RemoteBackend); ok && *sync {
+		if err := remote.Sync(ctx, *dir, true); err != nil {
+			return fmt.Errorf("syncing %s to %s: %w", *dir, *remoteHost, err)
+		}
+		defer func() {
+			if err := remote.Sync(ctx, *dir, false); err != nil {
+				fmt.Printf("run: syncing %s back from %s: %v\n", *dir, *remoteHost, err)
+			}
+		}()
+	}
+
+	out, err := b.Run(ctx, *dir, command, cmdArgs)
+	fmt.Print(out)
+	if err != nil {
+		return err
+	}
+	return nil
+}
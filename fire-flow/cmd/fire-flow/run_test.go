@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+func TestRunCommandLocalBackendRunsCommand(t *testing.T) {
+	dir := t.TempDir()
+	err := (runCommand{}).Run([]string{"-dir", dir, "-backend", "local", "--", "true"})
+	if err != nil {
+		t.Fatalf("run -backend local -- true: %v", err)
+	}
+}
+
+func TestRunCommandLocalBackendPropagatesFailure(t *testing.T) {
+	dir := t.TempDir()
+	err := (runCommand{}).Run([]string{"-dir", dir, "-backend", "local", "--", "false"})
+	if err == nil {
+		t.Fatal("run -- false should propagate the command's failure")
+	}
+}
+
+func TestRunCommandContainerBackendRequiresImage(t *testing.T) {
+	dir := t.TempDir()
+	err := (runCommand{}).Run([]string{"-dir", dir, "-backend", "container", "--", "true"})
+	if err == nil {
+		t.Fatal("run -backend container without -image should fail")
+	}
+}
+
+func TestRunCommandRequiresACommand(t *testing.T) {
+	if err := (runCommand{}).Run(nil); err == nil {
+		t.Fatal("run with no trailing command should fail")
+	}
+}
+
+func TestRunCommandRemoteBackendRequiresHostAndDir(t *testing.T) {
+	dir := t.TempDir()
+	err := (runCommand{}).Run([]string{"-dir", dir, "-backend", "remote", "--", "true"})
+	if err == nil {
+		t.Fatal("run -backend remote without -remote-host/-remote-dir should fail")
+	}
+}
+
+func TestRunCommandNixActivationFailureIsReported(t *testing.T) {
+	dir := t.TempDir()
+	// No flake.nix/devenv.nix in dir, and no nix/devenv binary is
+	// assumed present in the test environment, so activation should
+	// fail cleanly rather than silently falling through to running the
+	// command unactivated.
+	err := (runCommand{}).Run([]string{
+		"-dir", dir,
+		"-nix-kind", "flake",
+		"-nix-cache-dir", dir + "/nix-cache",
+		"--", "true",
+	})
+	if err == nil {
+		t.Fatal("run -nix-kind flake without a usable nix installation should fail")
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/procgroup"
+	"github.com/lprior-repo/fire-flow/internal/resource"
+	"github.com/lprior-repo/fire-flow/internal/testsession"
+)
+
+// cgroupParent is the cgroup v2 parent fire-flow creates a per-run leaf
+// cgroup under when -cgroup-cpu or -cgroup-mem is set.
+const cgroupParent = "/sys/fs/cgroup/fire-flow"
+
+// hungTestGrace is how recently the last test event must have arrived,
+// relative to a timeout firing, for the run to be classified as a slow
+// suite instead of a hung test: a suite still producing output right up
+// to the deadline is just slow, while one that went quiet well before
+// the deadline is stuck on whatever test ran last.
+const hungTestGrace = 2 * time.Second
+
+// runTestsCommand implements `fire-flow run-tests`, running `go test
+// -json` over a package pattern and saving the result as a labeled
+// testsession.Session so a later `fire-flow compare` can diff it
+// against another labeled run, e.g. a baseline before an AI attempt.
+type runTestsCommand struct{}
+
+func (runTestsCommand) Name() string { return "run-tests" }
+func (runTestsCommand) Synopsis() string {
+	return "run go test -json and save the result under --label for later comparison"
+}
+
+func (runTestsCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("run-tests", flag.ContinueOnError)
+	label := fs.String("label", "", "name to save this run's session under, e.g. before/after")
+	pkg := fs.String("pkg", "./...", "package pattern to test")
+	dir := fs.String("dir", ".opencode/tcr/sessions", "directory to save labeled test sessions in")
+	timeout := fs.Duration("timeout", 0, "kill the whole test process group if the run exceeds this; 0 means no timeout")
+	cgroupCPU := fs.Float64("cgroup-cpu", 0, "cap CPU to this many cores via a cgroup v2 leaf (linux only); 0 means unlimited")
+	cgroupMem := fs.Int64("cgroup-mem", 0, "cap memory to this many bytes via a cgroup v2 leaf (linux only); 0 means unlimited")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *label == "" {
+		return fmt.Errorf("usage: fire-flow run-tests --label <name> [-pkg pattern] [-dir path] [-timeout duration]")
+	}
+
+	var cgroup *resource.Cgroup
+	if *cgroupCPU > 0 || *cgroupMem > 0 {
+		if runtime.GOOS != "linux" {
+			return fmt.Errorf("-cgroup-cpu/-cgroup-mem require linux (cgroup v2), running on %s", runtime.GOOS)
+		}
+		var err error
+		cgroup, err = resource.NewCgroup(cgroupParent, *label, resource.Limits{CPUQuota: *cgroupCPU, MemoryBytes: *cgroupMem})
+		if err != nil {
+			return fmt.Errorf("creating cgroup for -label %s: %w", *label, err)
+		}
+		defer func() {
+			if err := cgroup.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "run-tests: removing cgroup: %v\n", err)
+			}
+		}()
+	}
+
+	cmd := exec.Command("go", "test", "-json", *pkg)
+	procgroup.Setup(cmd)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("piping go test output: %w", err)
+	}
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting go test: %w", err)
+	}
+	if cgroup != nil {
+		if err := cgroup.AddProcess(cmd.Process.Pid); err != nil {
+			_ = procgroup.Kill(cmd)
+			return fmt.Errorf("joining cgroup: %w", err)
+		}
+	}
+
+	activity := &activityTracker{last: time.Now()}
+	sessionCh := make(chan sessionResult, 1)
+	go func() {
+		session, buildErr := testsession.BuildSession(context.Background(), *label, activity.wrap(stdout))
+		sessionCh <- sessionResult{session, buildErr}
+	}()
+
+	var timeoutCh <-chan time.Time
+	if *timeout > 0 {
+		t := time.NewTimer(*timeout)
+		defer t.Stop()
+		timeoutCh = t.C
+	}
+
+	var result sessionResult
+	var timedOut bool
+	var quietFor time.Duration
+	select {
+	case result = <-sessionCh:
+	case <-timeoutCh:
+		timedOut = true
+		quietFor = time.Since(activity.lastSeen())
+		if err := procgroup.Kill(cmd); err != nil {
+			fmt.Fprintf(os.Stderr, "run-tests: killing process group after timeout: %v\n", err)
+		}
+		result = <-sessionCh
+	}
+	runErr := cmd.Wait()
+
+	if cgroup != nil {
+		if hit := cgroup.CheckHit(); hit.Any() {
+			fmt.Fprintf(os.Stderr, "run-tests: cgroup limit hit: %+v\n", hit)
+		}
+	}
+
+	if timedOut {
+		classification := "slow suite (still producing output right up to the deadline)"
+		if quietFor > hungTestGrace {
+			classification = fmt.Sprintf("hung test (no output for %s before the timeout)", quietFor.Round(time.Second))
+		}
+		fmt.Fprintf(os.Stderr, "go test timed out after %s: %s\n", *timeout, classification)
+	}
+	if result.err != nil {
+		return fmt.Errorf("parsing go test output: %w", result.err)
+	}
+
+	if err := testsession.Save(*dir, result.session); err != nil {
+		return err
+	}
+	fmt.Printf("saved session %q (%d tests) to %s\n", *label, len(result.session.Tests), *dir)
+
+	// A failing test run is expected input for this command, not a
+	// command failure: the whole point is to capture the session for
+	// comparison even when it's red, so runErr is reported but doesn't
+	// make Run itself fail.
+	if runErr != nil {
+		fmt.Fprintf(os.Stderr, "go test exited non-zero: %v\n", runErr)
+	}
+	return nil
+}
+
+// sessionResult carries testsession.BuildSession's result across the
+// goroutine reading it, so the timeout select above can race it against
+// the timer without blocking Run's main flow on a slow parse.
+type sessionResult struct {
+	session testsession.Session
+	err     error
+}
+
+// activityTracker records when data was last read from the test
+// process's output, so a timeout can be classified as a hung single
+// test (long quiet gap beforehand) versus a suite that was simply slow
+// but still actively producing output.
+type activityTracker struct {
+	mu   sync.Mutex
+	last time.Time
+}
+
+func (a *activityTracker) touch() {
+	a.mu.Lock()
+	a.last = time.Now()
+	a.mu.Unlock()
+}
+
+func (a *activityTracker) lastSeen() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.last
+}
+
+func (a *activityTracker) wrap(r io.Reader) io.Reader {
+	return &trackedReader{r: r, tracker: a}
+}
+
+type trackedReader struct {
+	r       io.Reader
+	tracker *activityTracker
+}
+
+func (t *trackedReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if n > 0 {
+		t.tracker.touch()
+	}
+	return n, err
+}
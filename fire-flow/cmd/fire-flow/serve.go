@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/daemon"
+	"github.com/lprior-repo/fire-flow/internal/shell"
+)
+
+// serveCommand implements `fire-flow serve`, the daemon mode the
+// systemd unit fire-flow service install writes actually execs: it
+// loads the multi-project registry and RBAC tokens, mounts the control
+// API, and listens until interrupted.
+type serveCommand struct{}
+
+func (serveCommand) Name() string     { return "serve" }
+func (serveCommand) Synopsis() string { return "run the daemon control API for one or more projects" }
+
+func (serveCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	unixSocket := fs.String("unix-socket", "", "unix socket path to listen on (default when -address is unset)")
+	address := fs.String("address", "", "host:port to listen on instead of a unix socket")
+	allowNetwork := fs.Bool("allow-network", false, "required to bind -address at all")
+	certFile := fs.String("cert", "", "TLS cert; required for -address unless it's loopback-only")
+	keyFile := fs.String("key", "", "TLS key")
+	registryPath := fs.String("registry", "fire-flow-registry.yaml", "project registry config")
+	tokensPath := fs.String("tokens", "fire-flow-tokens.yaml", "RBAC token config")
+	idempotencyPath := fs.String("idempotency", ".fire-flow-idempotency.json", "path to the idempotency record store")
+	runCmd := fs.String("run-cmd", "", `shell-quoted command run per /run call, with {project} and {root_dir} substituted, e.g. "fire-flow mutate -config {root_dir}/mutation-test-config.yaml"`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *unixSocket == "" && *address == "" {
+		*unixSocket = "fire-flow.sock"
+	}
+
+	registry, err := daemon.LoadRegistry(*registryPath)
+	if err != nil {
+		return err
+	}
+	tokens, err := daemon.LoadTokens(*tokensPath)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("serve: no RBAC tokens resolved from %s; the control API can mount filesystems and run commands, so it refuses to start with no way to authenticate", *tokensPath)
+	}
+
+	server := daemon.NewServer(registry, daemon.NewTokenStore(tokens, 5, 10), *idempotencyPath)
+	if *runCmd != "" {
+		server.Runner = func(p *daemon.Project) error {
+			return runProjectCommand(*runCmd, p)
+		}
+	}
+
+	listener, err := daemon.Listen(daemon.ListenConfig{
+		UnixSocket:   *unixSocket,
+		Address:      *address,
+		AllowNetwork: *allowNetwork,
+		CertFile:     *certFile,
+		KeyFile:      *keyFile,
+	})
+	if err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{Handler: server.Handler()}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		_ = httpServer.Close()
+	}()
+
+	fmt.Printf("fire-flow serve: listening, %d project(s) registered\n", len(registry.List()))
+	if err := httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("serving control API: %w", err)
+	}
+	return nil
+}
+
+// runProjectCommand runs template against project, substituting
+// {project} and {root_dir}, the same substitution style epic.go's
+// --bead-cmd uses for {bead_id}.
+func runProjectCommand(template string, project *daemon.Project) error {
+	command := strings.NewReplacer("{project}", project.Name, "{root_dir}", project.RootDir).Replace(template)
+	argv, err := shell.Split(command)
+	if err != nil {
+		return fmt.Errorf("parsing -run-cmd: %w", err)
+	}
+	if len(argv) == 0 {
+		return fmt.Errorf("-run-cmd parsed to no arguments")
+	}
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Dir = project.RootDir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lprior-repo/fire-flow/internal/daemon"
+)
+
+func TestServeCommandRefusesToStartWithNoTokens(t *testing.T) {
+	dir := t.TempDir()
+	registry := filepath.Join(dir, "registry.yaml")
+	tokens := filepath.Join(dir, "tokens.yaml")
+	if err := os.WriteFile(tokens, []byte("tokens: []\n"), 0o644); err != nil {
+		t.Fatalf("writing token config: %v", err)
+	}
+
+	err := (serveCommand{}).Run([]string{"-registry", registry, "-tokens", tokens})
+	if err == nil {
+		t.Fatal("serve with no resolvable tokens should fail rather than start unauthenticated")
+	}
+}
+
+func TestRunProjectCommandSubstitutesPlaceholders(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out.txt")
+	project := &daemon.Project{Name: "demo", RootDir: dir}
+
+	if err := runProjectCommand("sh -c \"echo {project} > "+out+"\"", project); err != nil {
+		t.Fatalf("runProjectCommand: %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("reading command output: %v", err)
+	}
+	if got := string(data); got != "demo\n" {
+		t.Fatalf("output = %q, want %q", got, "demo\n")
+	}
+}
+
+func TestRunProjectCommandWrapsFailure(t *testing.T) {
+	project := &daemon.Project{Name: "demo", RootDir: t.TempDir()}
+	if err := runProjectCommand("false", project); err == nil {
+		t.Fatal("runProjectCommand with a failing command should return an error")
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/lprior-repo/fire-flow/internal/service"
+)
+
+// serviceCommand implements `fire-flow service install|start|status` for
+// managing the systemd user unit that runs the watch/serve daemon.
+type serviceCommand struct{}
+
+func (serviceCommand) Name() string     { return "service" }
+func (serviceCommand) Synopsis() string { return "install/start/check the systemd user unit" }
+
+func (serviceCommand) Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: fire-flow service install|start|status")
+	}
+	switch args[0] {
+	case "install":
+		fs := flag.NewFlagSet("service install", flag.ContinueOnError)
+		exec := fs.String("exec", "", "path to the fire-flow binary")
+		workDir := fs.String("workdir", "", "working directory for the daemon")
+		serveArgs := fs.String("serve-args", "", "extra args passed to `fire-flow serve`")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *exec == "" {
+			var err error
+			*exec, err = os.Executable()
+			if err != nil {
+				return fmt.Errorf("resolving fire-flow binary path: %w", err)
+			}
+		}
+		if *workDir == "" {
+			var err error
+			*workDir, err = os.Getwd()
+			if err != nil {
+				return fmt.Errorf("resolving working directory: %w", err)
+			}
+		}
+		path, err := service.Install(service.UnitOptions{ExecPath: *exec, WorkingDir: *workDir, Args: *serveArgs})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("installed %s\n", path)
+		return nil
+	case "start":
+		if err := service.Start(); err != nil {
+			return err
+		}
+		fmt.Println("started fire-flow.service")
+		return nil
+	case "status":
+		out, err := service.Status()
+		if err != nil {
+			return err
+		}
+		fmt.Println(out)
+		return nil
+	default:
+		return fmt.Errorf("unknown service subcommand %q", args[0])
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/state"
+)
+
+// stateCommand implements `fire-flow state`, currently just the `restore`
+// subcommand for rolling back to a rotated state.json backup.
+type stateCommand struct{}
+
+func (stateCommand) Name() string     { return "state" }
+func (stateCommand) Synopsis() string { return "inspect or restore fire-flow's persisted state" }
+
+func (stateCommand) Run(args []string) error {
+	if len(args) == 0 || args[0] != "restore" {
+		return fmt.Errorf("usage: fire-flow state restore --from <n>")
+	}
+	fs := flag.NewFlagSet("state restore", flag.ContinueOnError)
+	path := fs.String("state", "state.json", "path to state.json")
+	from := fs.Int("from", 1, "backup number to restore (1 = most recent)")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	store := state.NewStore(*path, *from)
+	if err := store.Restore(*from); err != nil {
+		return err
+	}
+	fmt.Printf("restored %s from backup .%d\n", *path, *from)
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/lprior-repo/fire-flow/pkg/client"
+)
+
+// tailCommand implements `fire-flow tail [session]`, attaching to a
+// running daemon's control API and streaming one session's event log
+// live, so an operator can watch a remote runner's current cycle
+// without SSH-ing in to tail raw log files.
+type tailCommand struct{}
+
+func (tailCommand) Name() string     { return "tail" }
+func (tailCommand) Synopsis() string { return "stream a running session's event log live" }
+
+func (tailCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("tail", flag.ContinueOnError)
+	baseURL := fs.String("base-url", "http://localhost:8080", "daemon control API base URL")
+	token := fs.String("token", os.Getenv("FIRE_FLOW_TOKEN"), "daemon API token")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: fire-flow tail [-base-url url] [-token token] <session>")
+	}
+	session := fs.Arg(0)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := client.New(*baseURL, *token)
+	return c.Tail(ctx, session, func(line string) {
+		fmt.Println(line)
+	})
+}
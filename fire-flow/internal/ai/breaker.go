@@ -0,0 +1,115 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BreakerState is where a CircuitBreaker sits in the classic
+// closed/open/half-open cycle.
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half_open"
+)
+
+// AlertFunc is called whenever the breaker trips open, so the caller can
+// emit an event (log, digest, Slack) without this package knowing about
+// any specific sink.
+type AlertFunc func(provider string, consecutiveFailures int)
+
+// CircuitBreaker wraps a Runner and stops dispatching to it after
+// MaxFailures consecutive failures, so a down or misbehaving model
+// endpoint doesn't burn through every bead in the queue one at a time.
+// After CoolDown it allows one probe attempt (half-open); success closes
+// the breaker again, failure reopens it for another CoolDown.
+type CircuitBreaker struct {
+	Provider    string
+	Inner       Runner
+	MaxFailures int
+	CoolDown    time.Duration
+	OnTrip      AlertFunc
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a closed breaker around inner.
+func NewCircuitBreaker(provider string, inner Runner, maxFailures int, coolDown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		Provider:    provider,
+		Inner:       inner,
+		MaxFailures: maxFailures,
+		CoolDown:    coolDown,
+		state:       BreakerClosed,
+	}
+}
+
+// State reports the breaker's current state without side effects.
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState()
+}
+
+// currentState must be called with mu held; it transitions Open ->
+// HalfOpen once CoolDown has elapsed.
+func (b *CircuitBreaker) currentState() BreakerState {
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.CoolDown && !b.probing {
+		b.state = BreakerHalfOpen
+	}
+	return b.state
+}
+
+// Run implements Runner, rejecting calls while the breaker is open and
+// otherwise delegating to Inner and updating the breaker's state from
+// the outcome.
+func (b *CircuitBreaker) Run(ctx context.Context, prompt string) (string, error) {
+	b.mu.Lock()
+	state := b.currentState()
+	if state == BreakerOpen {
+		b.mu.Unlock()
+		return "", fmt.Errorf("ai: circuit breaker open for provider %s (%d consecutive failures)", b.Provider, b.failures)
+	}
+	if state == BreakerHalfOpen {
+		if b.probing {
+			b.mu.Unlock()
+			return "", fmt.Errorf("ai: circuit breaker for provider %s already probing recovery", b.Provider)
+		}
+		b.probing = true
+	}
+	b.mu.Unlock()
+
+	out, err := b.Inner.Run(ctx, prompt)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if err != nil {
+		b.failures++
+		if b.failures >= b.MaxFailures {
+			b.trip()
+		}
+		return out, err
+	}
+	b.failures = 0
+	b.state = BreakerClosed
+	return out, nil
+}
+
+// trip must be called with mu held.
+func (b *CircuitBreaker) trip() {
+	wasOpen := b.state == BreakerOpen
+	b.state = BreakerOpen
+	b.openedAt = time.Now()
+	if !wasOpen && b.OnTrip != nil {
+		b.OnTrip(b.Provider, b.failures)
+	}
+}
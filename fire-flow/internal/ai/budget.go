@@ -0,0 +1,82 @@
+package ai
+
+import "fmt"
+
+// Budget caps how many tokens a prompt (or one section of it) may
+// consume, so a bead with a huge description or a multi-thousand-line
+// diff can't silently blow past the model's context window.
+type Budget struct {
+	MaxTokens int
+}
+
+// DefaultBudget is a conservative per-section cap that leaves headroom
+// for the model's own response inside a typical 8k-token context
+// window; callers with a larger window should build their own Budget.
+func DefaultBudget() Budget {
+	return Budget{MaxTokens: 2000}
+}
+
+// bytesPerToken approximates English/code text at roughly 4 bytes per
+// token. Fire-flow has no dependency on a real tokenizer, and an
+// approximation is enough to keep prompts in the right ballpark and to
+// decide what to cut, not to bill usage exactly.
+const bytesPerToken = 4
+
+// EstimateTokens approximates s's token count from its byte length.
+func EstimateTokens(s string) int {
+	return (len(s) + bytesPerToken - 1) / bytesPerToken
+}
+
+// Omission records that a prompt section was cut down to fit its
+// budget, so a caller can log what the model never saw.
+type Omission struct {
+	Section        string
+	OriginalTokens int
+	KeptTokens     int
+}
+
+// String renders an Omission as a one-line log message.
+func (o Omission) String() string {
+	return fmt.Sprintf("ai: omitted %d of %d estimated tokens from %s to fit context budget", o.OriginalTokens-o.KeptTokens, o.OriginalTokens, o.Section)
+}
+
+// Budgeter fits prompt sections (bead descriptions, diffs, test output)
+// into a token budget, recording what it had to cut so the caller can
+// log or surface it instead of the AI silently receiving a truncated
+// view with no trace of what was dropped.
+type Budgeter struct {
+	Budget    Budget
+	Omissions []Omission
+}
+
+// NewBudgeter returns a Budgeter enforcing b, with no omissions yet.
+func NewBudgeter(b Budget) *Budgeter {
+	return &Budgeter{Budget: b}
+}
+
+// Fit returns text unchanged if it already fits within maxTokens
+// (falling back to the Budgeter's default Budget.MaxTokens when
+// maxTokens is 0), or a truncated copy with a trailer noting how much
+// was cut, recording an Omission either way it truncated.
+func (b *Budgeter) Fit(section, text string, maxTokens int) string {
+	if maxTokens == 0 {
+		maxTokens = b.Budget.MaxTokens
+	}
+	total := EstimateTokens(text)
+	if total <= maxTokens {
+		return text
+	}
+	maxBytes := maxTokens * bytesPerToken
+	if maxBytes > len(text) {
+		maxBytes = len(text)
+	}
+	kept := text[:maxBytes]
+	b.Omissions = append(b.Omissions, Omission{Section: section, OriginalTokens: total, KeptTokens: EstimateTokens(kept)})
+	return kept + fmt.Sprintf("\n... (truncated, %d of ~%d estimated tokens shown)", EstimateTokens(kept), total)
+}
+
+// Omitted reports every section this Budgeter has had to cut so far, in
+// the order they were fitted.
+func (b *Budgeter) Omitted() []Omission {
+	return b.Omissions
+}
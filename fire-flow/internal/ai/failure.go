@@ -0,0 +1,59 @@
+package ai
+
+// FailureClass categorizes why an AI run attempt didn't produce a green,
+// mergeable result.
+type FailureClass string
+
+const (
+	FailureNone            FailureClass = ""
+	FailureModelTimeout    FailureClass = "model_timeout"
+	FailureToolError       FailureClass = "tool_error"
+	FailureTestsFailing    FailureClass = "tests_failing"
+	FailureCriteriaUnmet   FailureClass = "bead_criteria_unmet"
+	FailurePolicyViolation FailureClass = "policy_violation"
+)
+
+// RetryPolicy says whether, and how many times, to retry a given failure
+// class.
+type RetryPolicy struct {
+	Retry      bool
+	MaxRetries int
+}
+
+// defaultRetryPolicies encodes fire-flow's default handling per failure
+// class: transient failures (timeouts, tool errors) get retried more
+// aggressively than failures that reflect the AI genuinely not meeting
+// the bar, and policy violations are never retried automatically.
+var defaultRetryPolicies = map[FailureClass]RetryPolicy{
+	FailureModelTimeout:    {Retry: true, MaxRetries: 3},
+	FailureToolError:       {Retry: true, MaxRetries: 3},
+	FailureTestsFailing:    {Retry: true, MaxRetries: 2},
+	FailureCriteriaUnmet:   {Retry: true, MaxRetries: 1},
+	FailurePolicyViolation: {Retry: false, MaxRetries: 0},
+}
+
+// PolicyFor returns the retry policy for a failure class, defaulting to a
+// single retry for any class not explicitly configured.
+func PolicyFor(class FailureClass) RetryPolicy {
+	if p, ok := defaultRetryPolicies[class]; ok {
+		return p
+	}
+	return RetryPolicy{Retry: true, MaxRetries: 1}
+}
+
+// AttemptStats aggregates failure classes across a bead's AI run
+// attempts, for inclusion in the bead report.
+type AttemptStats struct {
+	Counts map[FailureClass]int
+}
+
+// NewAttemptStats returns an empty AttemptStats ready for Record calls.
+func NewAttemptStats() *AttemptStats {
+	return &AttemptStats{Counts: make(map[FailureClass]int)}
+}
+
+// Record tallies one attempt's failure class. FailureNone is recorded
+// too, so Counts[FailureNone] doubles as the successful-attempt count.
+func (s *AttemptStats) Record(class FailureClass) {
+	s.Counts[class]++
+}
@@ -0,0 +1,93 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
+)
+
+// HealthCheck probes a locally-served model (e.g. local/qwen3-coder
+// behind an OpenAI-compatible server) before beads are dispatched to
+// it, so a cold or crashed server fails fast with a clear error instead
+// of every queued bead timing out against a dead endpoint one at a
+// time.
+type HealthCheck struct {
+	// Endpoint is the server's health/readiness URL, e.g.
+	// http://localhost:8080/health.
+	Endpoint string
+	// StartCommand, when set, is run (with StartArgs) if the initial
+	// probe fails, to bring the server up before waiting for it to
+	// become ready. Left unset, a failed probe is just reported as an
+	// error with no attempt to start anything.
+	StartCommand string
+	StartArgs    []string
+	// PollInterval and Timeout bound how long Ensure waits for the
+	// endpoint to come up after starting it.
+	PollInterval time.Duration
+	Timeout      time.Duration
+
+	client *http.Client
+}
+
+// DefaultHealthCheck returns a HealthCheck polling every second for up
+// to 30s, with no auto-start command configured.
+func DefaultHealthCheck(endpoint string) HealthCheck {
+	return HealthCheck{
+		Endpoint:     endpoint,
+		PollInterval: time.Second,
+		Timeout:      30 * time.Second,
+	}
+}
+
+// Probe reports whether the endpoint currently answers with a
+// non-error status, without starting anything.
+func (h HealthCheck) Probe(ctx context.Context) bool {
+	client := h.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.Endpoint, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode < 300
+}
+
+// Ensure probes the endpoint and returns immediately if it's already
+// up. Otherwise, if StartCommand is configured, it starts the server
+// process (detached; Ensure does not wait for it to exit) and polls
+// until Probe succeeds or Timeout elapses. With no StartCommand
+// configured, a failed probe is a fatal error: there's nothing to
+// start, so waiting would only stall the pipeline for no reason.
+func (h HealthCheck) Ensure(ctx context.Context) error {
+	if h.Probe(ctx) {
+		return nil
+	}
+	if h.StartCommand == "" {
+		return fmt.Errorf("ai: local model endpoint %s is not responding and no start command is configured", h.Endpoint)
+	}
+	cmd := exec.CommandContext(context.Background(), h.StartCommand, h.StartArgs...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("ai: starting local model server %q: %w", h.StartCommand, err)
+	}
+
+	deadline := time.Now().Add(h.Timeout)
+	for time.Now().Before(deadline) {
+		if h.Probe(ctx) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(h.PollInterval):
+		}
+	}
+	return fmt.Errorf("ai: local model endpoint %s did not become ready within %s of starting %q", h.Endpoint, h.Timeout, h.StartCommand)
+}
@@ -0,0 +1,112 @@
+package ai
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/lprior-repo/fire-flow/internal/observability"
+)
+
+// OutputSink describes where an ExecRunner's subprocess stdout/stderr
+// are teed to, on top of always being captured for Run's own return
+// value. Before this existed, run-ai inherited the parent process's
+// stdout/stderr directly, which meant parallel workers' output
+// interleaved unreadably; a sink with a Prefix gives each worker its
+// own destination and label instead.
+type OutputSink struct {
+	// File, when set, appends prefixed output lines to this path.
+	File string
+	// EventStream, when set, sends each line as a LogEntry to it,
+	// labeled with Prefix under the "worker" key.
+	EventStream *observability.LogSink
+	// RunID tags EventStream entries so they can be correlated back to
+	// the orchestration run that produced them (see LogEntry.RunID).
+	RunID string
+	// Prefix labels each line, e.g. "worker-3", so parallel workers'
+	// output stays attributable in both File and EventStream.
+	Prefix string
+}
+
+// discard reports whether s routes output nowhere, i.e. the zero value.
+func (s OutputSink) discard() bool {
+	return s.File == "" && s.EventStream == nil
+}
+
+// writer returns an io.Writer fanning lines out to s's configured
+// destinations (io.Discard if s is the zero value), plus a closer the
+// caller must Close once the subprocess finishes.
+func (s OutputSink) writer() (io.Writer, io.Closer, error) {
+	if s.discard() {
+		return io.Discard, io.NopCloser(nil), nil
+	}
+
+	var writers []io.Writer
+	closer := io.NopCloser(nil)
+	if s.File != "" {
+		f, err := os.OpenFile(s.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening AI output sink %s: %w", s.File, err)
+		}
+		writers = append(writers, &linePrefixer{w: f, prefix: s.Prefix})
+		closer = f
+	}
+	if s.EventStream != nil {
+		writers = append(writers, &eventStreamWriter{sink: s.EventStream, runID: s.RunID, prefix: s.Prefix})
+	}
+	return io.MultiWriter(writers...), closer, nil
+}
+
+// linePrefixer prepends prefix to every line written, buffering partial
+// lines across Write calls the way subprocess output naturally arrives
+// in arbitrary chunks rather than whole lines.
+type linePrefixer struct {
+	w      io.Writer
+	prefix string
+	buf    []byte
+}
+
+func (p *linePrefixer) Write(data []byte) (int, error) {
+	p.buf = append(p.buf, data...)
+	for {
+		i := bytes.IndexByte(p.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := p.buf[:i]
+		p.buf = p.buf[i+1:]
+		if _, err := fmt.Fprintf(p.w, "[%s] %s\n", p.prefix, line); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// eventStreamWriter turns each line of subprocess output into a LogEntry
+// sent to an observability.LogSink.
+type eventStreamWriter struct {
+	sink   *observability.LogSink
+	runID  string
+	prefix string
+	buf    []byte
+}
+
+func (w *eventStreamWriter) Write(data []byte) (int, error) {
+	w.buf = append(w.buf, data...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := string(w.buf[:i])
+		w.buf = w.buf[i+1:]
+		w.sink.Send(observability.LogEntry{
+			RunID:   w.runID,
+			Level:   "info",
+			Message: line,
+			Labels:  map[string]string{"worker": w.prefix},
+		})
+	}
+	return len(data), nil
+}
@@ -0,0 +1,71 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+)
+
+// FailingTest is one test failure to surface in a retry prompt.
+type FailingTest struct {
+	Name   string
+	Output string
+}
+
+// InitialPrompt builds the prompt handed to the AI for a fresh attempt
+// at issue, budgeting its Description so a bead with an unusually long
+// body (a pasted stack trace, a long design doc) can't crowd out the
+// instructions around it. budgeter is optional (pass nil to fall back
+// to DefaultBudget).
+func InitialPrompt(issue bead.Issue, budgeter *Budgeter) string {
+	if budgeter == nil {
+		budgeter = NewBudgeter(DefaultBudget())
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Bead %s: %s\n\n", issue.ID, issue.Title)
+	b.WriteString(budgeter.Fit(fmt.Sprintf("bead description (%s)", issue.ID), issue.Description, 0))
+	return b.String()
+}
+
+// RetryPrompt builds the prompt for a retried AI run after a bead's test
+// run failed. It bundles the failing test names and output excerpts plus
+// the current overlay diff so the retry has concrete signal about what
+// went wrong, instead of just repeating the original task and the same
+// mistake. sinceLastAttempt is optional (pass "" for the first retry);
+// when set it's the file-level delta since the previous attempt (see
+// overlay.DiffAttempts), so reviewers and the model see the iteration
+// delta instead of having to diff two full diffs themselves.
+// triedAndFailed is optional (see cycle.PromptSection); when set it
+// lists prior reverted approaches for this bead so the AI doesn't
+// repeat a dead end. budgeter is optional (pass nil to fall back to
+// DefaultBudget); when the bead's failing-test output or overlay diff
+// is large, budgeter fits each into its own share of the context
+// window and records what it had to cut (see Budgeter.Omitted) instead
+// of the prompt silently growing past the model's limit.
+func RetryPrompt(originalTask string, failures []FailingTest, overlayDiff, sinceLastAttempt, triedAndFailed string, budgeter *Budgeter) string {
+	if budgeter == nil {
+		budgeter = NewBudgeter(DefaultBudget())
+	}
+	var b strings.Builder
+	b.WriteString(originalTask)
+	if triedAndFailed != "" {
+		b.WriteString("\n\n")
+		b.WriteString(triedAndFailed)
+	}
+	b.WriteString("\n\nThe previous attempt failed. Failing tests:\n")
+	for _, f := range failures {
+		fmt.Fprintf(&b, "- %s\n", f.Name)
+		if f.Output != "" {
+			fmt.Fprintf(&b, "  output: %s\n", budgeter.Fit(fmt.Sprintf("failing test output (%s)", f.Name), f.Output, 500))
+		}
+	}
+	if sinceLastAttempt != "" {
+		b.WriteString("\nWhat changed since the previous attempt:\n")
+		b.WriteString(sinceLastAttempt)
+	}
+	b.WriteString("\nCurrent diff against the base:\n```diff\n")
+	b.WriteString(budgeter.Fit("overlay diff", overlayDiff, 2000))
+	b.WriteString("\n```\n\nFix the failing tests without reverting unrelated changes.\n")
+	return b.String()
+}
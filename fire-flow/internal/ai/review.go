@@ -0,0 +1,83 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Verdict is a reviewer model's judgment on whether a diff satisfies a
+// bead's acceptance criteria.
+type Verdict struct {
+	Approved bool
+	Reason   string
+}
+
+// ReviewResult records both models' opinions on one attempt, so a
+// disagreement can be surfaced with full context instead of just a
+// pass/fail bit.
+type ReviewResult struct {
+	Author   Verdict
+	Reviewer Verdict
+	// NeedsHuman is true when the author considered the work done but
+	// the reviewer didn't; the attempt must not be pushed in this case.
+	NeedsHuman bool
+}
+
+// Reviewer asks a second, independently configured model whether an
+// overlay diff actually satisfies a bead's acceptance criteria, so a
+// single model's self-assessment isn't the only gate a change has to
+// clear before it lands.
+type Reviewer struct {
+	Model Runner
+}
+
+// NewReviewer returns a Reviewer that asks model for its verdict.
+func NewReviewer(model Runner) Reviewer {
+	return Reviewer{Model: model}
+}
+
+// Review builds a review prompt from criteria and diff, asks the
+// reviewer model, and parses its verdict. The author's own verdict
+// (typically "approved" once its tests pass) is passed in rather than
+// re-derived, so this package doesn't need to know how the author
+// decided it was done.
+func (r Reviewer) Review(ctx context.Context, criteria, diff string, author Verdict) (ReviewResult, error) {
+	prompt := reviewPrompt(criteria, diff)
+	out, err := r.Model.Run(ctx, prompt)
+	if err != nil {
+		return ReviewResult{}, fmt.Errorf("ai: running reviewer model: %w", err)
+	}
+	reviewer := parseVerdict(out)
+	return ReviewResult{
+		Author:     author,
+		Reviewer:   reviewer,
+		NeedsHuman: author.Approved && !reviewer.Approved,
+	}, nil
+}
+
+func reviewPrompt(criteria, diff string) string {
+	var b strings.Builder
+	b.WriteString("You are reviewing a change against its acceptance criteria. Reply with the first line exactly APPROVE or REJECT, followed by a one-line reason.\n\n")
+	b.WriteString("Acceptance criteria:\n")
+	b.WriteString(criteria)
+	b.WriteString("\n\nDiff:\n```diff\n")
+	b.WriteString(diff)
+	b.WriteString("\n```\n")
+	return b.String()
+}
+
+// parseVerdict reads the first line of a reviewer's response as its
+// verdict; any response that doesn't start with the exact token APPROVE
+// is treated as a rejection, since a reviewer whose output we can't
+// confidently parse as approval should never silently pass a change.
+func parseVerdict(response string) Verdict {
+	line, rest, _ := strings.Cut(strings.TrimSpace(response), "\n")
+	fields := strings.Fields(line)
+	approved := len(fields) > 0 && fields[0] == "APPROVE"
+	reason := strings.TrimSpace(rest)
+	if reason == "" {
+		reason = strings.TrimSpace(line)
+	}
+	return Verdict{Approved: approved, Reason: reason}
+}
@@ -0,0 +1,51 @@
+// Package ai invokes a configured AI provider to generate or triage code
+// on fire-flow's behalf, mirroring the role bitter-truth/tools/generate.nu
+// plays for the Nushell-side pipeline.
+package ai
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+)
+
+// Runner sends a prompt to an AI provider and returns its response text.
+type Runner interface {
+	Run(ctx context.Context, prompt string) (string, error)
+}
+
+// ExecRunner shells out to a configured command (opencode, claude, a local
+// model wrapper), writing the prompt to stdin and reading the response
+// from stdout.
+type ExecRunner struct {
+	Command string
+	Args    []string
+
+	// Output routes the subprocess's stdout/stderr to a file, an
+	// observability.LogSink, both, or nowhere beyond the buffer Run
+	// captures for its own return value. The zero value discards it,
+	// same as before this field existed.
+	Output OutputSink
+}
+
+// Run implements Runner.
+func (r ExecRunner) Run(ctx context.Context, prompt string) (string, error) {
+	cmd := exec.CommandContext(ctx, r.Command, r.Args...)
+	cmd.Stdin = bytes.NewBufferString(prompt)
+
+	sink, closer, err := r.Output.writer()
+	if err != nil {
+		return "", err
+	}
+	defer closer.Close()
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = io.MultiWriter(&stdout, sink)
+	cmd.Stderr = io.MultiWriter(&stderr, sink)
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("running AI provider %q: %w (stderr: %s)", r.Command, err, stderr.String())
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,174 @@
+// Package anomaly watches fire-flow's own cycle history for statistical
+// drift — revert rate, cycle time, or AI failure rate climbing well past
+// its recent baseline — and raises an alert (and optionally pauses
+// dispatching) instead of letting a struggling AI or environment run
+// unnoticed until a human happens to check the dashboard.
+package anomaly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+	"github.com/lprior-repo/fire-flow/internal/state"
+)
+
+// Metric names one of the health signals Detect watches.
+type Metric string
+
+const (
+	MetricRevertRate    Metric = "revert_rate"
+	MetricCycleTime     Metric = "cycle_time_seconds"
+	MetricAIFailureRate Metric = "ai_failure_rate"
+)
+
+// Alert reports one metric whose recent value exceeded its rolling
+// baseline by more than the configured factor.
+type Alert struct {
+	Metric   Metric  `json:"metric"`
+	Baseline float64 `json:"baseline"`
+	Observed float64 `json:"observed"`
+	Factor   float64 `json:"factor"`
+	// Window is how many of the most recent cycles Observed was
+	// computed over.
+	Window int `json:"window"`
+}
+
+// Thresholds configures how far Observed may exceed Baseline before an
+// alert fires, and the window sizes used to compute each.
+type Thresholds struct {
+	RevertRateFactor    float64
+	CycleTimeFactor     float64
+	AIFailureRateFactor float64
+	// BaselineWindow is how many cycles before ObservedWindow form the
+	// rolling baseline.
+	BaselineWindow int
+	// ObservedWindow is how many of the most recent cycles are compared
+	// against that baseline.
+	ObservedWindow int
+}
+
+// DefaultThresholds doubles any metric over its trailing 50-cycle
+// baseline, judged over the most recent 10 cycles, as fire-flow's
+// built-in sensitivity.
+func DefaultThresholds() Thresholds {
+	return Thresholds{
+		RevertRateFactor:    2,
+		CycleTimeFactor:     2,
+		AIFailureRateFactor: 2,
+		BaselineWindow:      50,
+		ObservedWindow:      10,
+	}
+}
+
+// Detect compares the most recent t.ObservedWindow entries against the
+// baseline formed by up to t.BaselineWindow entries immediately before
+// them, returning one Alert per metric that exceeded its threshold
+// factor. It returns nil until there's enough history to form both
+// windows, so a freshly provisioned project never alerts on noise.
+func Detect(entries []cycle.Summary, t Thresholds) []Alert {
+	if t.ObservedWindow <= 0 || len(entries) < t.ObservedWindow+1 {
+		return nil
+	}
+	observed := entries[len(entries)-t.ObservedWindow:]
+	baselineStart := 0
+	if cut := len(entries) - t.ObservedWindow - t.BaselineWindow; cut > 0 {
+		baselineStart = cut
+	}
+	baseline := entries[baselineStart : len(entries)-t.ObservedWindow]
+	if len(baseline) == 0 {
+		return nil
+	}
+
+	var alerts []Alert
+	if a, ok := exceeds(MetricRevertRate, revertRate(baseline), revertRate(observed), t.RevertRateFactor, len(observed)); ok {
+		alerts = append(alerts, a)
+	}
+	if a, ok := exceeds(MetricCycleTime, avgCycleSeconds(baseline), avgCycleSeconds(observed), t.CycleTimeFactor, len(observed)); ok {
+		alerts = append(alerts, a)
+	}
+	// cycle.Summary has no dedicated AI-failure field; DecisionInfraError
+	// is fire-flow's catch-all for a cycle that couldn't complete (AI
+	// crash, timeout, environment failure), so it's the closest proxy
+	// available from history alone.
+	if a, ok := exceeds(MetricAIFailureRate, rateOf(baseline, cycle.DecisionInfraError), rateOf(observed, cycle.DecisionInfraError), t.AIFailureRateFactor, len(observed)); ok {
+		alerts = append(alerts, a)
+	}
+	return alerts
+}
+
+func exceeds(metric Metric, baselineVal, observedVal, factor float64, window int) (Alert, bool) {
+	if baselineVal <= 0 {
+		if observedVal <= 0 {
+			return Alert{}, false
+		}
+		return Alert{Metric: metric, Baseline: baselineVal, Observed: observedVal, Factor: factor, Window: window}, true
+	}
+	if observedVal > baselineVal*factor {
+		return Alert{Metric: metric, Baseline: baselineVal, Observed: observedVal, Factor: factor, Window: window}, true
+	}
+	return Alert{}, false
+}
+
+func revertRate(entries []cycle.Summary) float64 {
+	return rateOf(entries, cycle.DecisionReverted)
+}
+
+func rateOf(entries []cycle.Summary, decision cycle.Decision) float64 {
+	if len(entries) == 0 {
+		return 0
+	}
+	matched := 0
+	for _, e := range entries {
+		if e.Decision == decision {
+			matched++
+		}
+	}
+	return float64(matched) / float64(len(entries))
+}
+
+func avgCycleSeconds(entries []cycle.Summary) float64 {
+	if len(entries) == 0 {
+		return 0
+	}
+	var total float64
+	for _, e := range entries {
+		total += e.FinishedAt.Sub(e.StartedAt).Seconds()
+	}
+	return total / float64(len(entries))
+}
+
+// Webhook POSTs each alert as JSON to url, one request per alert, so a
+// receiver that only handles a single-alert payload shape still works.
+func Webhook(url string, alerts []Alert) error {
+	for _, alert := range alerts {
+		body, err := json.Marshal(alert)
+		if err != nil {
+			return fmt.Errorf("marshaling alert %s: %w", alert.Metric, err)
+		}
+		resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("posting alert %s to %s: %w", alert.Metric, url, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("posting alert %s to %s: status %d", alert.Metric, url, resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// PauseDispatch sets Paused on the project's state so the scheduler
+// stops starting new cycles until a human acknowledges the alert and
+// unpauses (e.g. via `fire-flow resume`), without touching in-flight
+// stats.
+func PauseDispatch(store *state.Store) error {
+	st, err := store.Load()
+	if err != nil {
+		return fmt.Errorf("loading state to pause dispatch: %w", err)
+	}
+	st.Paused = true
+	return store.Save(st)
+}
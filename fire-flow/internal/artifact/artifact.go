@@ -0,0 +1,88 @@
+// Package artifact abstracts where cycle transcripts, mutation reports,
+// and attestations land once produced on a runner host, so a fleet of
+// ephemeral workers can write to durable shared storage instead of a
+// disk that disappears with the host.
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Store puts, fetches, and lists artifacts by key (a slash-separated
+// path such as "bead-123/attempt-2/transcript.log"), independent of
+// whether they end up on local disk, S3, or GCS.
+type Store interface {
+	Put(ctx context.Context, key string, data io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// LocalStore keeps artifacts under Root on the local filesystem, the
+// same durability a runner host had before this package existed.
+type LocalStore struct {
+	Root string
+}
+
+// Put writes data to Root/key, creating parent directories as needed.
+func (s LocalStore) Put(_ context.Context, key string, data io.Reader) error {
+	path := filepath.Join(s.Root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("artifact: creating directory for %s: %w", key, err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("artifact: creating %s: %w", key, err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, data); err != nil {
+		return fmt.Errorf("artifact: writing %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get opens Root/key for reading.
+func (s LocalStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(s.Root, filepath.FromSlash(key)))
+	if err != nil {
+		return nil, fmt.Errorf("artifact: opening %s: %w", key, err)
+	}
+	return f, nil
+}
+
+// List returns every key under Root with the given prefix, in sorted
+// order.
+func (s LocalStore) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	root := s.Root
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("artifact: listing %s: %w", root, err)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
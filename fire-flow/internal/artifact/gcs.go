@@ -0,0 +1,110 @@
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// GCSStore puts artifacts in a Google Cloud Storage bucket via the JSON
+// API, bearer-authenticated with a caller-supplied OAuth2 access token
+// (fire-flow doesn't manage GCP credentials itself; a wrapper script or
+// workload identity is expected to hand it a live token).
+type GCSStore struct {
+	Bucket string
+	Token  string
+	HTTP   *http.Client
+}
+
+func (s GCSStore) client() *http.Client {
+	if s.HTTP != nil {
+		return s.HTTP
+	}
+	return http.DefaultClient
+}
+
+func (s GCSStore) authorize(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+s.Token)
+}
+
+// Put uploads data as the object named key via a simple (non-resumable)
+// media upload.
+func (s GCSStore) Put(ctx context.Context, key string, data io.Reader) error {
+	endpoint := fmt.Sprintf("https://storage.googleapis.com/upload/storage/v1/b/%s/o?uploadType=media&name=%s",
+		url.QueryEscape(s.Bucket), url.QueryEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	s.authorize(req)
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return fmt.Errorf("artifact: uploading %s to GCS: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("artifact: GCS upload of %s returned status %d: %s", key, resp.StatusCode, body)
+	}
+	return nil
+}
+
+// Get downloads the object named key.
+func (s GCSStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	endpoint := fmt.Sprintf("https://storage.googleapis.com/storage/v1/b/%s/o/%s?alt=media",
+		url.PathEscape(s.Bucket), url.QueryEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.authorize(req)
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("artifact: fetching %s from GCS: %w", key, err)
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("artifact: GCS fetch of %s returned status %d: %s", key, resp.StatusCode, body)
+	}
+	return resp.Body, nil
+}
+
+// List returns the object names under prefix from a single objects.list
+// request, i.e. at most one page; a nextPageToken in the response is not
+// followed.
+func (s GCSStore) List(ctx context.Context, prefix string) ([]string, error) {
+	endpoint := fmt.Sprintf("https://storage.googleapis.com/storage/v1/b/%s/o?prefix=%s",
+		url.PathEscape(s.Bucket), url.QueryEscape(prefix))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.authorize(req)
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("artifact: listing %s in GCS: %w", prefix, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("artifact: GCS list of %s returned status %d: %s", prefix, resp.StatusCode, body)
+	}
+	var parsed struct {
+		Items []struct {
+			Name string `json:"name"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("artifact: parsing GCS list response: %w", err)
+	}
+	keys := make([]string, len(parsed.Items))
+	for i, item := range parsed.Items {
+		keys[i] = item.Name
+	}
+	return keys, nil
+}
@@ -0,0 +1,234 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Store puts artifacts in an S3 bucket, signing requests with SigV4
+// by hand rather than pulling in the AWS SDK for three verbs.
+type S3Store struct {
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+	// Endpoint overrides the default AWS endpoint, for S3-compatible
+	// stores (MinIO, R2); leave empty for real S3.
+	Endpoint string
+	HTTP     *http.Client
+}
+
+func (s S3Store) endpoint() string {
+	if s.Endpoint != "" {
+		return strings.TrimSuffix(s.Endpoint, "/")
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", s.Bucket, s.Region)
+}
+
+func (s S3Store) client() *http.Client {
+	if s.HTTP != nil {
+		return s.HTTP
+	}
+	return http.DefaultClient
+}
+
+// Put uploads data as the object at key.
+func (s S3Store) Put(ctx context.Context, key string, data io.Reader) error {
+	body, err := io.ReadAll(data)
+	if err != nil {
+		return fmt.Errorf("artifact: reading %s for S3 upload: %w", key, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.endpoint()+"/"+key, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	s.sign(req, body)
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return fmt.Errorf("artifact: uploading %s to S3: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("artifact: S3 PUT %s returned status %d: %s", key, resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// Get downloads the object at key.
+func (s S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint()+"/"+key, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, nil)
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("artifact: fetching %s from S3: %w", key, err)
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("artifact: S3 GET %s returned status %d: %s", key, resp.StatusCode, respBody)
+	}
+	return resp.Body, nil
+}
+
+// List returns the keys under prefix from a single ListObjectsV2
+// request, i.e. at most one page (S3 caps a page at 1000 keys); the
+// continuation token of a truncated listing is not followed.
+func (s S3Store) List(ctx context.Context, prefix string) ([]string, error) {
+	q := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	// Built with the same RFC 3986 encoding sign() uses for the
+	// canonical query string (see canonicalQueryString), rather than
+	// url.Values.Encode's form-encoding, so the request S3 actually
+	// receives matches what got signed byte-for-byte.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint()+"/?"+canonicalQueryString(q), nil)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, nil)
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("artifact: listing %s in S3: %w", prefix, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("artifact: S3 ListObjectsV2 returned status %d: %s", resp.StatusCode, body)
+	}
+	var parsed struct {
+		Contents []struct {
+			Key string `xml:"Key"`
+		} `xml:"Contents"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("artifact: parsing S3 ListObjectsV2 response: %w", err)
+	}
+	keys := make([]string, len(parsed.Contents))
+	for i, c := range parsed.Contents {
+		keys[i] = c.Key
+	}
+	return keys, nil
+}
+
+// sign adds SigV4 Authorization, x-amz-date, and x-amz-content-sha256
+// headers to req for body (nil treated as empty, matching S3's
+// requirement for GET/LIST requests).
+func (s S3Store) sign(req *http.Request, body []byte) {
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	req.Host = req.URL.Host
+
+	signedHeaders, canonicalHeaders := canonicalize(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQueryString(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", dateStamp, s.Region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256(hmacSHA256(hmacSHA256(hmacSHA256([]byte("AWS4"+s.SecretKey), dateStamp), s.Region), "s3"), "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+// canonicalQueryString renders query params SigV4's way: sorted by key,
+// each key and value percent-encoded per RFC 3986 (uriEncode) rather
+// than url.Values.Encode's application/x-www-form-urlencoded rules,
+// which disagree on characters like space (%20 vs +) and would produce
+// a canonical request S3 doesn't recompute the same way, failing with
+// SignatureDoesNotMatch.
+func canonicalQueryString(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes s per RFC 3986 (SigV4's "UriEncode"):
+// unreserved characters (A-Z a-z 0-9 - _ . ~) pass through unescaped,
+// everything else becomes an uppercase-hex %XX escape.
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func canonicalize(req *http.Request) (signedHeaders, canonicalHeaders string) {
+	headers := map[string]string{
+		"host":                 req.Host,
+		"x-amz-content-sha256": req.Header.Get("x-amz-content-sha256"),
+		"x-amz-date":           req.Header.Get("x-amz-date"),
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canon strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canon, "%s:%s\n", name, headers[name])
+	}
+	return strings.Join(names, ";"), canon.String()
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,64 @@
+package artifact
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestUriEncodeMatchesRFC3986(t *testing.T) {
+	cases := map[string]string{
+		"hello":       "hello",
+		"hello world": "hello%20world",
+		"a+b":         "a%2Bb",
+		"a/b":         "a%2Fb",
+		"a-b_c.d~e":   "a-b_c.d~e",
+	}
+	for in, want := range cases {
+		if got := uriEncode(in); got != want {
+			t.Errorf("uriEncode(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCanonicalQueryStringEncodesSpacesAsPercent20(t *testing.T) {
+	values := url.Values{"prefix": {"builds/pr 42"}, "list-type": {"2"}}
+	got := canonicalQueryString(values)
+	want := "list-type=2&prefix=builds%2Fpr%2042"
+	if got != want {
+		t.Fatalf("canonicalQueryString = %q, want %q", got, want)
+	}
+	if strings.Contains(got, "+") {
+		t.Fatalf("canonicalQueryString = %q must not use form-encoded '+' for spaces", got)
+	}
+}
+
+func TestCanonicalQueryStringSortsByKey(t *testing.T) {
+	values := url.Values{"z": {"1"}, "a": {"2"}, "m": {"3"}}
+	got := canonicalQueryString(values)
+	want := "a=2&m=3&z=1"
+	if got != want {
+		t.Fatalf("canonicalQueryString = %q, want %q", got, want)
+	}
+}
+
+func TestSignProducesConsistentAuthorizationHeader(t *testing.T) {
+	store := S3Store{Bucket: "my-bucket", Region: "us-east-1", AccessKey: "AKIDEXAMPLE", SecretKey: "secret"}
+	req, err := http.NewRequest(http.MethodGet, store.endpoint()+"/?"+canonicalQueryString(url.Values{"prefix": {"a b"}}), nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	store.sign(req, nil)
+
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") {
+		t.Fatalf("Authorization header missing expected prefix: %q", auth)
+	}
+	if !strings.Contains(auth, "SignedHeaders=host;x-amz-content-sha256;x-amz-date") {
+		t.Fatalf("Authorization header missing expected signed headers: %q", auth)
+	}
+	if req.Header.Get("x-amz-content-sha256") == "" || req.Header.Get("x-amz-date") == "" {
+		t.Fatalf("sign should set x-amz-content-sha256 and x-amz-date headers")
+	}
+}
@@ -0,0 +1,98 @@
+// Package attestation generates in-toto/SLSA-style provenance
+// attestations for autonomous commits, recording what an unattended
+// fire-flow cycle actually did (bead, model, prompt, test results) and
+// what commit it produced, for compliance review of AI-authored changes.
+package attestation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+)
+
+// statementType and predicateType are the canonical in-toto/SLSA type
+// URIs; see https://slsa.dev/provenance/v0.2.
+const (
+	statementType = "https://in-toto.io/Statement/v0.1"
+	predicateType = "https://slsa.dev/provenance/v0.2"
+)
+
+// Subject identifies the artifact the attestation is about.
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Predicate is fire-flow's SLSA-flavored payload: what generated the
+// commit and what it verified before landing it.
+type Predicate struct {
+	BeadID      string    `json:"bead_id"`
+	Model       string    `json:"model"`
+	PromptHash  string    `json:"prompt_hash"`
+	TestsPassed int       `json:"tests_passed"`
+	TestsFailed int       `json:"tests_failed"`
+	Decision    string    `json:"decision"`
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+}
+
+// Statement is the full in-toto attestation document.
+type Statement struct {
+	Type          string    `json:"_type"`
+	PredicateType string    `json:"predicateType"`
+	Subject       []Subject `json:"subject"`
+	Predicate     Predicate `json:"predicate"`
+}
+
+// Build assembles a Statement for one cycle: summary carries the test
+// results and decision, commitSHA is the commit that landed, and model
+// and promptHash identify what produced it (promptHash lets an auditor
+// confirm which prompt was used without storing the prompt text itself).
+func Build(summary cycle.Summary, commitSHA, model, promptHash string) Statement {
+	return Statement{
+		Type:          statementType,
+		PredicateType: predicateType,
+		Subject: []Subject{{
+			Name:   "commit",
+			Digest: map[string]string{"sha1": commitSHA},
+		}},
+		Predicate: Predicate{
+			BeadID:      summary.BeadID,
+			Model:       model,
+			PromptHash:  promptHash,
+			TestsPassed: summary.TestsPassed,
+			TestsFailed: summary.TestsFailed,
+			Decision:    string(summary.Decision),
+			StartedAt:   summary.StartedAt,
+			FinishedAt:  summary.FinishedAt,
+		},
+	}
+}
+
+// Path returns the on-disk location for one bead/commit's attestation
+// under dir, conventionally .opencode/tcr/attestations (see
+// internal/provision for the rest of that layout).
+func Path(dir, beadID, commitSHA string) string {
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.json", beadID, commitSHA))
+}
+
+// Write marshals stmt and writes it to Path(dir, beadID, commitSHA),
+// creating dir as needed, and returns the path written.
+func Write(dir, beadID, commitSHA string, stmt Statement) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("creating attestations dir %s: %w", dir, err)
+	}
+	path := Path(dir, beadID, commitSHA)
+	data, err := json.MarshalIndent(stmt, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling attestation for %s: %w", beadID, err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("writing attestation to %s: %w", path, err)
+	}
+	return path, nil
+}
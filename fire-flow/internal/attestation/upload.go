@@ -0,0 +1,57 @@
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Uploader sends a written attestation somewhere durable outside the
+// repo, for teams whose compliance requirements need it retained
+// independent of the branch's history.
+type Uploader interface {
+	Upload(ctx context.Context, stmt Statement) error
+}
+
+// HTTPUploader POSTs the attestation's JSON body to Endpoint, bearer
+// -authenticated with Token when set. It's the same request shape as
+// pkg/client's do(), duplicated here rather than shared since this
+// package has no other reason to depend on the daemon's API client.
+type HTTPUploader struct {
+	Endpoint string
+	Token    string
+	HTTP     *http.Client
+}
+
+// Upload implements Uploader.
+func (u HTTPUploader) Upload(ctx context.Context, stmt Statement) error {
+	data, err := json.Marshal(stmt)
+	if err != nil {
+		return fmt.Errorf("marshaling attestation for upload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("building attestation upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if u.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+u.Token)
+	}
+	client := u.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("uploading attestation to %s: %w", u.Endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("uploading attestation to %s: status %d: %s", u.Endpoint, resp.StatusCode, string(body))
+	}
+	return nil
+}
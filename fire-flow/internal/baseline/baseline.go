@@ -0,0 +1,86 @@
+// Package baseline records which tests already fail when a bead
+// workspace is first provisioned, so the gate can tell a pre-existing
+// failure apart from one the current change introduced instead of
+// treating every red test run as equally the attempt's fault.
+package baseline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/lprior-repo/fire-flow/internal/teststate"
+)
+
+// Baseline is the set of tests that were already failing when a
+// workspace was provisioned.
+type Baseline struct {
+	FailedTests []string `json:"failed_tests"`
+}
+
+// Record runs `go test -json` output from r through teststate and
+// captures its failing tests as the baseline.
+func Record(ctx context.Context, r io.Reader) (Baseline, error) {
+	result, err := teststate.ParseGoTestOutputContext(ctx, r)
+	if err != nil {
+		return Baseline{}, err
+	}
+	return Baseline{FailedTests: result.FailedTests}, nil
+}
+
+// path returns dir/baseline.json.
+func path(dir string) string { return filepath.Join(dir, "baseline.json") }
+
+// Save writes b to dir/baseline.json, creating dir as needed.
+func Save(dir string, b Baseline) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating baseline dir %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling baseline: %w", err)
+	}
+	if err := os.WriteFile(path(dir), data, 0o644); err != nil {
+		return fmt.Errorf("writing baseline to %s: %w", path(dir), err)
+	}
+	return nil
+}
+
+// Load reads back a previously Saved baseline. A missing file returns a
+// zero-value Baseline (no pre-existing failures known) rather than an
+// error, so a workspace provisioned before a baseline was ever recorded
+// doesn't break the gate.
+func Load(dir string) (Baseline, error) {
+	data, err := os.ReadFile(path(dir))
+	if os.IsNotExist(err) {
+		return Baseline{}, nil
+	}
+	if err != nil {
+		return Baseline{}, fmt.Errorf("reading baseline %s: %w", path(dir), err)
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return Baseline{}, fmt.Errorf("parsing baseline %s: %w", path(dir), err)
+	}
+	return b, nil
+}
+
+// NewFailures returns result's failing tests that aren't in b, i.e. the
+// ones introduced since the baseline was recorded rather than
+// pre-existing.
+func NewFailures(result teststate.Result, b Baseline) []string {
+	known := make(map[string]bool, len(b.FailedTests))
+	for _, t := range b.FailedTests {
+		known[t] = true
+	}
+	var introduced []string
+	for _, t := range result.FailedTests {
+		if !known[t] {
+			introduced = append(introduced, t)
+		}
+	}
+	return introduced
+}
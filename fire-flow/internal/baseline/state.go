@@ -0,0 +1,25 @@
+package baseline
+
+import "github.com/lprior-repo/fire-flow/internal/teststate"
+
+// EffectiveState decides RED/GREEN the quarantine-aware way: a run is
+// GREEN as long as every failing test is either in the pre-existing
+// baseline or in quarantine, even when the raw failure count is
+// non-zero. This lets a legacy repo with known-broken tests adopt the
+// TCR flow instead of being permanently RED, while still catching any
+// newly introduced failure.
+func EffectiveState(result teststate.Result, b Baseline, quarantine []string) string {
+	ignored := make(map[string]bool, len(b.FailedTests)+len(quarantine))
+	for _, t := range b.FailedTests {
+		ignored[t] = true
+	}
+	for _, t := range quarantine {
+		ignored[t] = true
+	}
+	for _, t := range result.FailedTests {
+		if !ignored[t] {
+			return "RED"
+		}
+	}
+	return "GREEN"
+}
@@ -0,0 +1,169 @@
+package bead
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ArchiveResult reports what an Archive call actually did, for status
+// output.
+type ArchiveResult struct {
+	ArchivePath string
+	Archived    int
+	PrunedDirs  int
+	PruneErrs   map[string]error
+}
+
+// Archive moves every closed bead in beadsPath older than retention
+// (measured from CreatedAt, the only timestamp Issue carries) into a
+// gzip-compressed JSONL archive file under archiveDir, rewrites
+// beadsPath to keep only what remains, and, when artifactsDir is set,
+// removes each archived bead's artifact subdirectory
+// (artifactsDir/<bead-id>). Archived beads remain queryable via
+// ReadArchive; they are not deleted, only moved out of the live file.
+func Archive(beadsPath, archiveDir string, retention time.Duration, artifactsDir string) (ArchiveResult, error) {
+	issues, err := ReadAll(beadsPath)
+	if err != nil {
+		return ArchiveResult{}, err
+	}
+
+	cutoff := time.Now().Add(-retention)
+	var keep, toArchive []Issue
+	for _, issue := range issues {
+		if isArchivable(issue, cutoff) {
+			toArchive = append(toArchive, issue)
+		} else {
+			keep = append(keep, issue)
+		}
+	}
+	if len(toArchive) == 0 {
+		return ArchiveResult{}, nil
+	}
+
+	if err := os.MkdirAll(archiveDir, 0o755); err != nil {
+		return ArchiveResult{}, fmt.Errorf("creating archive directory %s: %w", archiveDir, err)
+	}
+	archivePath := filepath.Join(archiveDir, fmt.Sprintf("archive-%s.jsonl.gz", time.Now().UTC().Format("20060102T150405Z")))
+	if err := writeArchive(archivePath, toArchive); err != nil {
+		return ArchiveResult{}, err
+	}
+
+	if err := rewriteBeadsFile(beadsPath, keep); err != nil {
+		return ArchiveResult{}, err
+	}
+
+	result := ArchiveResult{ArchivePath: archivePath, Archived: len(toArchive), PruneErrs: map[string]error{}}
+	if artifactsDir != "" {
+		for _, issue := range toArchive {
+			dir := filepath.Join(artifactsDir, issue.ID)
+			if _, err := os.Stat(dir); os.IsNotExist(err) {
+				continue
+			}
+			if err := os.RemoveAll(dir); err != nil {
+				result.PruneErrs[issue.ID] = err
+				continue
+			}
+			result.PrunedDirs++
+		}
+	}
+	return result, nil
+}
+
+func isArchivable(issue Issue, cutoff time.Time) bool {
+	if issue.Status != "closed" {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339, issue.CreatedAt)
+	if err != nil {
+		return false
+	}
+	return created.Before(cutoff)
+}
+
+func writeArchive(path string, issues []Issue) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating archive file %s: %w", path, err)
+	}
+
+	gz := gzip.NewWriter(f)
+	enc := json.NewEncoder(gz)
+	for _, issue := range issues {
+		if err := enc.Encode(issue); err != nil {
+			gz.Close()
+			f.Close()
+			return fmt.Errorf("writing archived bead %s: %w", issue.ID, err)
+		}
+	}
+	// gzip.Writer buffers the final block until Close, so a write
+	// failure here (e.g. disk full) would otherwise go unnoticed and
+	// Archive would go on to delete the beads this file was supposed to
+	// preserve on the strength of a truncated .gz.
+	if err := gz.Close(); err != nil {
+		f.Close()
+		return fmt.Errorf("finalizing archive file %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing archive file %s: %w", path, err)
+	}
+	return nil
+}
+
+func rewriteBeadsFile(path string, issues []Issue) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("creating replacement beads file: %w", err)
+	}
+	enc := json.NewEncoder(f)
+	for _, issue := range issues {
+		if err := enc.Encode(issue); err != nil {
+			f.Close()
+			return fmt.Errorf("writing bead %s: %w", issue.ID, err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing replacement beads file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("replacing %s: %w", path, err)
+	}
+	return nil
+}
+
+// ReadArchive reads every issue back out of a gzip-compressed archive
+// file produced by Archive, so an archived bead can still be looked up
+// without restoring it to the live beads file.
+func ReadArchive(path string) ([]Issue, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening archive %s: %w", path, err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing archive %s: %w", path, err)
+	}
+	defer gz.Close()
+
+	var issues []Issue
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var issue Issue
+		if err := json.Unmarshal(scanner.Bytes(), &issue); err != nil {
+			return nil, fmt.Errorf("parsing archived bead in %s: %w", path, err)
+		}
+		issues = append(issues, issue)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning archive %s: %w", path, err)
+	}
+	return issues, nil
+}
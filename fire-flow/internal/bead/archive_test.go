@@ -0,0 +1,100 @@
+package bead
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeBeadsFile(t *testing.T, path string, issues []Issue) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("creating %s: %v", path, err)
+	}
+	defer f.Close()
+	for _, issue := range issues {
+		data, err := json.Marshal(issue)
+		if err != nil {
+			t.Fatalf("marshaling %v: %v", issue, err)
+		}
+		if _, err := f.Write(append(data, '\n')); err != nil {
+			t.Fatalf("writing bead: %v", err)
+		}
+	}
+}
+
+func TestArchiveMovesOldClosedBeads(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, "beads.jsonl")
+	old := time.Now().Add(-100 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().UTC().Format(time.RFC3339)
+	writeBeadsFile(t, beadsPath, []Issue{
+		{ID: "old-closed", Status: "closed", CreatedAt: old},
+		{ID: "recent-closed", Status: "closed", CreatedAt: recent},
+		{ID: "old-open", Status: "open", CreatedAt: old},
+	})
+
+	result, err := Archive(beadsPath, filepath.Join(dir, "archive"), 90*24*time.Hour, "")
+	if err != nil {
+		t.Fatalf("Archive: %v", err)
+	}
+	if result.Archived != 1 {
+		t.Fatalf("Archived = %d, want 1", result.Archived)
+	}
+
+	remaining, err := ReadAll(beadsPath)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(remaining) != 2 {
+		t.Fatalf("remaining beads = %d, want 2", len(remaining))
+	}
+	for _, issue := range remaining {
+		if issue.ID == "old-closed" {
+			t.Fatalf("old-closed bead should have been archived, not kept")
+		}
+	}
+
+	archived, err := ReadArchive(result.ArchivePath)
+	if err != nil {
+		t.Fatalf("ReadArchive: %v", err)
+	}
+	if len(archived) != 1 || archived[0].ID != "old-closed" {
+		t.Fatalf("archived = %v, want [old-closed]", archived)
+	}
+}
+
+func TestArchiveNoEligibleBeadsIsNoop(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, "beads.jsonl")
+	recent := time.Now().UTC().Format(time.RFC3339)
+	writeBeadsFile(t, beadsPath, []Issue{{ID: "recent-closed", Status: "closed", CreatedAt: recent}})
+
+	result, err := Archive(beadsPath, filepath.Join(dir, "archive"), 90*24*time.Hour, "")
+	if err != nil {
+		t.Fatalf("Archive: %v", err)
+	}
+	if result.ArchivePath != "" || result.Archived != 0 {
+		t.Fatalf("Archive with nothing eligible should be a no-op, got %+v", result)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "archive")); !os.IsNotExist(err) {
+		t.Fatalf("archive directory should not have been created")
+	}
+}
+
+func TestWriteArchivePropagatesWriteFailure(t *testing.T) {
+	dir := t.TempDir()
+	// A directory in place of the archive file makes the write fail,
+	// which should surface as an error from writeArchive rather than
+	// being silently swallowed (see the gz.Close error check).
+	badPath := filepath.Join(dir, "not-a-file")
+	if err := os.Mkdir(badPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := writeArchive(badPath, []Issue{{ID: "x", Status: "closed"}}); err == nil {
+		t.Fatalf("writeArchive against a directory should fail, got nil error")
+	}
+}
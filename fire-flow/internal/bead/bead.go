@@ -0,0 +1,63 @@
+// Package bead writes proposals into the beads work queue (.beads/*.jsonl)
+// so autonomous fire-flow subsystems can hand follow-up work back to a
+// human or the next AI cycle instead of acting on it silently.
+package bead
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Issue mirrors the subset of the beads schema (see .beads/config.yaml)
+// that fire-flow needs when filing new work.
+type Issue struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Priority    int    `json:"priority"`
+	IssueType   string `json:"issue_type"`
+	CreatedAt   string `json:"created_at"`
+	// Repos lists the repository URLs a cross-repo bead touches, beyond
+	// the primary repo it's filed in. Empty for the common single-repo
+	// case; see internal/multirepo for how a bead with more than one
+	// entry gets a provisioned session per repo.
+	Repos []string `json:"repos,omitempty"`
+	// EpicID, when set, is the parent epic this bead belongs to; see
+	// internal/epic for running every bead under an epic as a batch.
+	EpicID string `json:"epic_id,omitempty"`
+	// DependsOn lists bead IDs that must be closed before this one is
+	// eligible to run, used by internal/epic to order a batch run.
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+// AppendProposal appends a new open task issue to a beads JSONL file. The
+// ID is a temporary, run-scoped identifier (idPrefix-proposal-n); `bd`
+// assigns the permanent ID on its next sync.
+func AppendProposal(path, idPrefix, title, description string, priority, n int) (Issue, error) {
+	issue := Issue{
+		ID:          fmt.Sprintf("%s-proposal-%d", idPrefix, n),
+		Title:       title,
+		Description: description,
+		Status:      "open",
+		Priority:    priority,
+		IssueType:   "task",
+		CreatedAt:   time.Now().UTC().Format(time.RFC3339),
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return Issue{}, fmt.Errorf("opening beads file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(issue)
+	if err != nil {
+		return Issue{}, fmt.Errorf("marshaling bead proposal: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return Issue{}, fmt.Errorf("writing bead proposal to %s: %w", path, err)
+	}
+	return issue, nil
+}
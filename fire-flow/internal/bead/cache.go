@@ -0,0 +1,67 @@
+package bead
+
+import (
+	"os"
+	"sync"
+)
+
+// Cache wraps ReadAll with an in-process, per-path read-through cache,
+// so a caller that polls the beads store at high frequency doesn't
+// re-parse the whole JSONL file on every call. A cached
+// entry is invalidated automatically when the file's mtime changes, and
+// can be dropped early with Invalidate after a known mutation (e.g. this
+// process's own AppendProposal).
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	modTime int64
+	issues  []Issue
+}
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return &Cache{entries: make(map[string]cacheEntry)}
+}
+
+// ReadAll returns path's issues, using the cached copy when the file's
+// mtime hasn't changed since it was cached, else re-reading through to
+// ReadAll and caching the fresh result.
+func (c *Cache) ReadAll(path string) ([]Issue, error) {
+	info, statErr := os.Stat(path)
+	var modTime int64
+	if statErr == nil {
+		modTime = info.ModTime().UnixNano()
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[path]; ok && statErr == nil && entry.modTime == modTime {
+		issues := entry.issues
+		c.mu.Unlock()
+		return issues, nil
+	}
+	c.mu.Unlock()
+
+	issues, err := ReadAll(path)
+	if err != nil {
+		return nil, err
+	}
+	if statErr == nil {
+		c.mu.Lock()
+		c.entries[path] = cacheEntry{modTime: modTime, issues: issues}
+		c.mu.Unlock()
+	}
+	return issues, nil
+}
+
+// Invalidate drops path's cached entry, forcing the next ReadAll to
+// re-read from disk regardless of mtime. Useful right after this
+// process writes to path itself, when a filesystem's mtime resolution
+// might not have ticked between the write and the next read.
+func (c *Cache) Invalidate(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, path)
+}
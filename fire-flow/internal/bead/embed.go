@@ -0,0 +1,32 @@
+package bead
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+)
+
+// hashDims is the local embedder's vector width; large enough that word
+// hash collisions rarely change which beads look similar, small enough
+// to keep FindSimilar cheap over a whole open backlog.
+const hashDims = 256
+
+// HashEmbedder is a dependency-free local Embedder: it buckets each word
+// of the text into one of hashDims dimensions by hash and counts
+// occurrences. It's a coarse bag-of-words signal, not a semantic
+// embedding, but it needs no model or API key and still catches the
+// common case FindSimilar exists for: two proposals worded almost
+// identically. Point Embed at a real local model or API for anything
+// more nuanced.
+type HashEmbedder struct{}
+
+// Embed implements Embedder.
+func (HashEmbedder) Embed(_ context.Context, text string) ([]float64, error) {
+	vec := make([]float64, hashDims)
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		sum := sha256.Sum256([]byte(word))
+		bucket := int(sum[0])<<8 | int(sum[1])
+		vec[bucket%hashDims]++
+	}
+	return vec, nil
+}
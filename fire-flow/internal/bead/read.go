@@ -0,0 +1,37 @@
+package bead
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ReadAll reads every issue from a beads JSONL file, in file order. A
+// missing file is treated as an empty backlog, the same convention
+// history.Read and gitops.PushQueue.Drain use.
+func ReadAll(path string) ([]Issue, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening beads file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var issues []Issue
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var issue Issue
+		if err := json.Unmarshal(scanner.Bytes(), &issue); err != nil {
+			return nil, fmt.Errorf("parsing bead in %s: %w", path, err)
+		}
+		issues = append(issues, issue)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning beads file %s: %w", path, err)
+	}
+	return issues, nil
+}
@@ -0,0 +1,82 @@
+package bead
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Embedder produces a vector embedding for a piece of text, backed by
+// either a local model or a remote API; callers own which.
+type Embedder interface {
+	Embed(ctx context.Context, text string) ([]float64, error)
+}
+
+// SimilarBead is an existing open bead whose description embedding is
+// close enough to a candidate's to warrant a human look before filing a
+// likely duplicate.
+type SimilarBead struct {
+	Issue      Issue
+	Similarity float64 // cosine similarity in [-1, 1]; 1 means identical direction
+}
+
+// DefaultSimilarityThreshold is the cosine similarity at or above which two
+// bead descriptions are flagged as likely duplicates. Chosen
+// conservatively (most unrelated task descriptions land well below 0.85)
+// to avoid false positives blocking legitimate parallel work.
+const DefaultSimilarityThreshold = 0.85
+
+// FindSimilar embeds candidateDescription and every existing issue's
+// Description, returning any whose cosine similarity reaches threshold,
+// most similar first. It's meant to run before AppendProposal or a
+// tracker import commits a new bead, so two workers don't independently
+// implement the same thing.
+func FindSimilar(ctx context.Context, embedder Embedder, candidateDescription string, existing []Issue, threshold float64) ([]SimilarBead, error) {
+	candidateVec, err := embedder.Embed(ctx, candidateDescription)
+	if err != nil {
+		return nil, fmt.Errorf("embedding candidate description: %w", err)
+	}
+
+	var matches []SimilarBead
+	for _, issue := range existing {
+		if issue.Status != "open" {
+			continue
+		}
+		vec, err := embedder.Embed(ctx, issue.Description)
+		if err != nil {
+			return nil, fmt.Errorf("embedding %s: %w", issue.ID, err)
+		}
+		sim, err := cosineSimilarity(candidateVec, vec)
+		if err != nil {
+			return nil, fmt.Errorf("comparing candidate to %s: %w", issue.ID, err)
+		}
+		if sim >= threshold {
+			matches = append(matches, SimilarBead{Issue: issue, Similarity: sim})
+		}
+	}
+
+	for i := 0; i < len(matches); i++ {
+		for j := i + 1; j < len(matches); j++ {
+			if matches[j].Similarity > matches[i].Similarity {
+				matches[i], matches[j] = matches[j], matches[i]
+			}
+		}
+	}
+	return matches, nil
+}
+
+func cosineSimilarity(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("embedding dimension mismatch: %d vs %d", len(a), len(b))
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0, nil
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB)), nil
+}
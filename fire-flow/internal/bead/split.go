@@ -0,0 +1,54 @@
+package bead
+
+import "fmt"
+
+// Budget caps how large a single bead's change is allowed to grow before
+// fire-flow suggests splitting it, instead of letting one bead balloon
+// into an unreviewable diff.
+type Budget struct {
+	MaxFiles     int
+	MaxLinesDiff int
+}
+
+// DefaultBudget mirrors the review-friendly size most of this repo's own
+// commits stay under.
+func DefaultBudget() Budget {
+	return Budget{MaxFiles: 15, MaxLinesDiff: 400}
+}
+
+// SplitSuggestion proposes breaking one bead's changes into several,
+// grouped by the files each touches.
+type SplitSuggestion struct {
+	Reason string
+	Groups [][]string // each inner slice is one proposed sub-bead's files
+}
+
+// Suggest checks a bead's current diff stats against budget and, if
+// exceeded, groups changedFiles into roughly even chunks as a starting
+// point for splitting. It doesn't understand dependencies between files;
+// it's a suggestion for a human or the AI runner to refine, not an
+// automatic split.
+func Suggest(changedFiles []string, linesDiff int, budget Budget) *SplitSuggestion {
+	overFiles := len(changedFiles) > budget.MaxFiles
+	overLines := linesDiff > budget.MaxLinesDiff
+	if !overFiles && !overLines {
+		return nil
+	}
+
+	groupSize := budget.MaxFiles
+	if groupSize <= 0 {
+		groupSize = len(changedFiles)
+	}
+	var groups [][]string
+	for i := 0; i < len(changedFiles); i += groupSize {
+		end := i + groupSize
+		if end > len(changedFiles) {
+			end = len(changedFiles)
+		}
+		groups = append(groups, changedFiles[i:end])
+	}
+
+	reason := fmt.Sprintf("touches %d files and %d changed lines, over the budget of %d files / %d lines",
+		len(changedFiles), linesDiff, budget.MaxFiles, budget.MaxLinesDiff)
+	return &SplitSuggestion{Reason: reason, Groups: groups}
+}
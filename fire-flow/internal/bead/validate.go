@@ -0,0 +1,96 @@
+package bead
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ValidStatuses are the bead lifecycle states fire-flow understands
+// elsewhere (similar.go's open-only dedup check, tracker/sync.go's
+// TransitionMap keys).
+var ValidStatuses = []string{"open", "in_progress", "blocked", "closed"}
+
+// QuarantinedLine is one JSONL line a sync rejected, kept along with why
+// so a human can fix it and re-run the sync instead of the whole import
+// failing over one bad record.
+type QuarantinedLine struct {
+	Line   int    `json:"line"`
+	Raw    string `json:"raw"`
+	Reason string `json:"reason"`
+}
+
+// ValidationReport is the outcome of validating a beads JSONL file: the
+// issues that passed, and the lines that didn't.
+type ValidationReport struct {
+	Valid       []Issue           `json:"valid"`
+	Quarantined []QuarantinedLine `json:"quarantined"`
+}
+
+// Validate reads path line by line, keeping well-formed issues with
+// required fields, a recognized status, and a unique ID in Valid, and
+// collecting every other line in Quarantined with a line number and
+// reason instead of failing the whole read.
+func Validate(path string) (ValidationReport, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return ValidationReport{}, nil
+	}
+	if err != nil {
+		return ValidationReport{}, fmt.Errorf("opening beads file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var report ValidationReport
+	seenIDs := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		raw := scanner.Text()
+		issue, reason := validateLine(raw, seenIDs)
+		if reason != "" {
+			report.Quarantined = append(report.Quarantined, QuarantinedLine{Line: lineNo, Raw: raw, Reason: reason})
+			continue
+		}
+		seenIDs[issue.ID] = true
+		report.Valid = append(report.Valid, issue)
+	}
+	if err := scanner.Err(); err != nil {
+		return report, fmt.Errorf("scanning beads file %s: %w", path, err)
+	}
+	return report, nil
+}
+
+// validateLine parses and checks a single JSONL line, returning a
+// non-empty reason instead of an error so the caller can quarantine it.
+func validateLine(raw string, seenIDs map[string]bool) (Issue, string) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(raw), &issue); err != nil {
+		return Issue{}, fmt.Sprintf("invalid JSON: %v", err)
+	}
+	if issue.ID == "" {
+		return Issue{}, "missing required field: id"
+	}
+	if issue.Title == "" {
+		return Issue{}, "missing required field: title"
+	}
+	if !isValidStatus(issue.Status) {
+		return Issue{}, fmt.Sprintf("invalid status %q", issue.Status)
+	}
+	if seenIDs[issue.ID] {
+		return Issue{}, fmt.Sprintf("duplicate id %q", issue.ID)
+	}
+	return issue, ""
+}
+
+func isValidStatus(status string) bool {
+	for _, s := range ValidStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+// Package bench times fire-flow's overlay paths directly (mount latency,
+// commit throughput, stale-scan time) so results are comparable across
+// releases without needing `go test -bench`, which this repo doesn't use.
+package bench
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+)
+
+// Result is one benchmark's outcome.
+type Result struct {
+	Name     string
+	Duration time.Duration
+	Detail   string
+}
+
+// MountLatency mounts and unmounts n times via m, reporting mean
+// latency.
+func MountLatency(m overlay.Mounter, scratchDir string, n int) (Result, error) {
+	var total time.Duration
+	for i := 0; i < n; i++ {
+		root := filepath.Join(scratchDir, fmt.Sprintf("mount-bench-%d", i))
+		cfg := overlay.MountConfig{
+			Lower:  filepath.Join(root, "lower"),
+			Upper:  filepath.Join(root, "upper"),
+			Work:   filepath.Join(root, "work"),
+			Merged: filepath.Join(root, "merged"),
+		}
+		if err := os.MkdirAll(cfg.Lower, 0o755); err != nil {
+			return Result{}, fmt.Errorf("preparing bench lower dir: %w", err)
+		}
+		start := time.Now()
+		session, err := m.Mount(cfg)
+		if err != nil {
+			return Result{}, fmt.Errorf("mount %d: %w", i, err)
+		}
+		if err := session.Close(); err != nil {
+			return Result{}, fmt.Errorf("unmount %d: %w", i, err)
+		}
+		total += time.Since(start)
+	}
+	mean := total / time.Duration(n)
+	return Result{Name: "mount-latency", Duration: mean, Detail: fmt.Sprintf("%d iterations", n)}, nil
+}
+
+// CommitThroughput populates an overlay upper layer with fileCount files
+// of fileSize bytes each and times overlay.Commit.
+func CommitThroughput(m overlay.Mounter, scratchDir string, fileCount int, fileSize int64) (Result, error) {
+	root := filepath.Join(scratchDir, "commit-bench")
+	cfg := overlay.MountConfig{
+		Lower:  filepath.Join(root, "lower"),
+		Upper:  filepath.Join(root, "upper"),
+		Work:   filepath.Join(root, "work"),
+		Merged: filepath.Join(root, "merged"),
+	}
+	if err := os.MkdirAll(cfg.Upper, 0o755); err != nil {
+		return Result{}, fmt.Errorf("preparing bench upper dir: %w", err)
+	}
+	if err := os.MkdirAll(cfg.Lower, 0o755); err != nil {
+		return Result{}, fmt.Errorf("preparing bench lower dir: %w", err)
+	}
+	payload := make([]byte, fileSize)
+	for i := 0; i < fileCount; i++ {
+		path := filepath.Join(cfg.Upper, fmt.Sprintf("file-%d.bin", i))
+		if err := os.WriteFile(path, payload, 0o644); err != nil {
+			return Result{}, fmt.Errorf("writing bench file %s: %w", path, err)
+		}
+	}
+
+	session := &overlay.Session{Config: cfg}
+	start := time.Now()
+	committed, err := overlay.Commit(session, overlay.CommitOptions{})
+	if err != nil {
+		return Result{}, fmt.Errorf("committing bench files: %w", err)
+	}
+	elapsed := time.Since(start)
+	totalBytes := int64(len(committed)) * fileSize
+	mbPerSec := float64(totalBytes) / elapsed.Seconds() / (1024 * 1024)
+	return Result{
+		Name:     "commit-throughput",
+		Duration: elapsed,
+		Detail:   fmt.Sprintf("%d files, %.2f MB/s", len(committed), mbPerSec),
+	}, nil
+}
+
+// StaleScan times scanning /proc/self/mountinfo for the stale-mount
+// check, so regressions in overlay.RefreshRecords/StaleRecords on hosts
+// with many mounts are caught before release.
+func StaleScan(records []overlay.MountRecord) (Result, error) {
+	start := time.Now()
+	if _, err := overlay.RefreshRecords(records); err != nil {
+		return Result{}, fmt.Errorf("scanning mounts: %w", err)
+	}
+	return Result{Name: "stale-scan", Duration: time.Since(start), Detail: fmt.Sprintf("%d records", len(records))}, nil
+}
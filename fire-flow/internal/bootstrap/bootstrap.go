@@ -0,0 +1,164 @@
+// Package bootstrap turns a template repository into a running
+// autonomous fire-flow project in one step: clone, detect the language
+// preset, seed the initial bead backlog, and drop in the Kestra flow
+// that drives cycles.
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+)
+
+// Preset is a detected project language/toolchain, used to pick
+// sensible defaults for the generated Kestra flow's test command.
+type Preset string
+
+const (
+	PresetGo      Preset = "go"
+	PresetRust    Preset = "rust"
+	PresetNode    Preset = "node"
+	PresetUnknown Preset = "unknown"
+)
+
+// presetMarkers maps a manifest file, checked in order, to the preset it
+// implies.
+var presetMarkers = []struct {
+	file   string
+	preset Preset
+}{
+	{"go.mod", PresetGo},
+	{"Cargo.toml", PresetRust},
+	{"package.json", PresetNode},
+}
+
+// Options configures a bootstrap run.
+type Options struct {
+	TemplateRepoURL string
+	DestDir         string
+	BeadsPath       string // defaults to .beads/issues.jsonl under DestDir
+	KestraFlowPath  string // defaults to .kestra/fire-flow.yaml under DestDir
+}
+
+// Result reports what Bootstrap did.
+type Result struct {
+	DestDir       string
+	Preset        Preset
+	BeadsCreated  int
+	KestraFlowSet bool
+}
+
+// Bootstrap clones opts.TemplateRepoURL into opts.DestDir, detects the
+// preset, seeds beads from BACKLOG.md if present, and writes a starter
+// Kestra flow.
+func Bootstrap(opts Options) (Result, error) {
+	if opts.BeadsPath == "" {
+		opts.BeadsPath = filepath.Join(opts.DestDir, ".beads", "issues.jsonl")
+	}
+	if opts.KestraFlowPath == "" {
+		opts.KestraFlowPath = filepath.Join(opts.DestDir, ".kestra", "fire-flow.yaml")
+	}
+	result := Result{DestDir: opts.DestDir}
+
+	if err := run("", "clone", opts.TemplateRepoURL, opts.DestDir); err != nil {
+		return result, fmt.Errorf("cloning template %s: %w", opts.TemplateRepoURL, err)
+	}
+
+	result.Preset = DetectPreset(opts.DestDir)
+
+	backlogPath := filepath.Join(opts.DestDir, "BACKLOG.md")
+	if data, err := os.ReadFile(backlogPath); err == nil {
+		items := ParseBacklog(string(data))
+		if err := os.MkdirAll(filepath.Dir(opts.BeadsPath), 0o755); err != nil {
+			return result, fmt.Errorf("creating beads dir: %w", err)
+		}
+		for i, item := range items {
+			if _, err := bead.AppendProposal(opts.BeadsPath, "bootstrap", item, "", 2, i+1); err != nil {
+				return result, fmt.Errorf("seeding bead %q: %w", item, err)
+			}
+		}
+		result.BeadsCreated = len(items)
+	} else if !os.IsNotExist(err) {
+		return result, fmt.Errorf("reading %s: %w", backlogPath, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(opts.KestraFlowPath), 0o755); err != nil {
+		return result, fmt.Errorf("creating Kestra flow dir: %w", err)
+	}
+	if err := os.WriteFile(opts.KestraFlowPath, []byte(kestraFlow(result.Preset)), 0o644); err != nil {
+		return result, fmt.Errorf("writing Kestra flow %s: %w", opts.KestraFlowPath, err)
+	}
+	result.KestraFlowSet = true
+
+	return result, nil
+}
+
+// DetectPreset inspects destDir's root for a known manifest file.
+func DetectPreset(destDir string) Preset {
+	for _, marker := range presetMarkers {
+		if _, err := os.Stat(filepath.Join(destDir, marker.file)); err == nil {
+			return marker.preset
+		}
+	}
+	return PresetUnknown
+}
+
+// ParseBacklog extracts one bead title per top-level markdown list item
+// ("- " or "* " at the start of a line) from a BACKLOG.md, ignoring
+// headings and blank lines.
+func ParseBacklog(markdown string) []string {
+	var items []string
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") {
+			items = append(items, strings.TrimSpace(trimmed[2:]))
+		}
+	}
+	return items
+}
+
+// testCommandFor returns the preset's conventional test invocation, for
+// the generated Kestra flow's gate step.
+func testCommandFor(p Preset) string {
+	switch p {
+	case PresetGo:
+		return "go build ./... && go vet ./... && go test ./..."
+	case PresetRust:
+		return "cargo test"
+	case PresetNode:
+		return "npm test"
+	default:
+		return "echo 'no test command detected; edit this flow'"
+	}
+}
+
+func kestraFlow(p Preset) string {
+	return fmt.Sprintf(`id: fire-flow-cycle
+namespace: fire-flow
+tasks:
+  - id: run-cycle
+    type: io.kestra.plugin.core.flow.WorkingDirectory
+    tasks:
+      - id: mutate
+        type: io.kestra.plugin.scripts.shell.Commands
+        commands:
+          - fire-flow mutate
+      - id: test
+        type: io.kestra.plugin.scripts.shell.Commands
+        commands:
+          - %s
+`, testCommandFor(p))
+}
+
+func run(dir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Package budget provides a shared concurrency pool that fire-flow's
+// independently-parallel subsystems (test runs, mutation runs, AI
+// workers, overlay commits) all draw job slots from, so a full cycle
+// with everything running at once doesn't oversubscribe the host's CPUs
+// by having each subsystem size its own worker pool in isolation.
+package budget
+
+import (
+	"context"
+	"runtime"
+)
+
+// Pool is a fixed-size slot pool; callers Acquire a slot before doing
+// CPU-bound work and release it when done.
+type Pool struct {
+	slots chan struct{}
+}
+
+// NewPool returns a Pool with size concurrent slots, clamped to at least
+// one so a misconfigured 0 or negative size doesn't deadlock every
+// caller.
+func NewPool(size int) *Pool {
+	if size <= 0 {
+		size = 1
+	}
+	return &Pool{slots: make(chan struct{}, size)}
+}
+
+// DefaultSize is the slot count a Pool gets when a team hasn't
+// configured one explicitly: one slot per logical CPU, matching what an
+// unbounded goroutine-per-core worker pool would already assume.
+func DefaultSize() int {
+	return runtime.NumCPU()
+}
+
+// Acquire blocks until a slot is free or ctx is done, returning a
+// release func to call when the caller's work finishes. release is safe
+// to call at most once; call it via defer immediately after a nil error.
+func (p *Pool) Acquire(ctx context.Context) (release func(), err error) {
+	select {
+	case p.slots <- struct{}{}:
+		return func() { <-p.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Available reports how many slots are currently free.
+func (p *Pool) Available() int {
+	return cap(p.slots) - len(p.slots)
+}
+
+// Size returns the pool's total slot count.
+func (p *Pool) Size() int {
+	return cap(p.slots)
+}
@@ -0,0 +1,77 @@
+// Package capability detects which host features fire-flow's overlay
+// mounts, sandboxing, and container backends depend on, so behavior
+// differences across runner hosts are explainable ("no overlayfs here,
+// falling back to FakeMounter") instead of silently degrading.
+package capability
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Capability names one host feature fire-flow can conditionally use.
+type Capability string
+
+const (
+	Overlayfs      Capability = "overlayfs"
+	UserNamespaces Capability = "user_namespaces"
+	Fuse           Capability = "fuse"
+	Cgroups        Capability = "cgroups"
+	Docker         Capability = "docker"
+)
+
+// Set is a detected snapshot of which capabilities this host has.
+type Set map[Capability]bool
+
+// String renders a Set as a sorted "name=yes/no, ..." line, for doctor
+// and status output.
+func (s Set) String() string {
+	names := make([]string, 0, len(s))
+	for c := range s {
+		names = append(names, string(c))
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		status := "no"
+		if s[Capability(name)] {
+			status = "yes"
+		}
+		fmt.Fprintf(&b, "%s=%s", name, status)
+	}
+	return b.String()
+}
+
+var (
+	once   sync.Once
+	cached Set
+)
+
+// Detect probes every known capability and caches the result for the
+// rest of the process's lifetime: what a host supports doesn't change
+// while fire-flow is running, so repeated callers (doctor, the daemon's
+// /capabilities endpoint, the overlay mounter picking a backend) don't
+// each pay the probing cost.
+func Detect() Set {
+	once.Do(func() {
+		cached = Set{
+			Overlayfs:      detectOverlayfs(),
+			UserNamespaces: detectUserNamespaces(),
+			Fuse:           detectFuse(),
+			Cgroups:        detectCgroups(),
+			Docker:         detectDocker(),
+		}
+	})
+	return cached
+}
+
+func detectDocker() bool {
+	_, err := exec.LookPath("docker")
+	return err == nil
+}
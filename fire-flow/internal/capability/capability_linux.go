@@ -0,0 +1,48 @@
+//go:build linux
+
+package capability
+
+import (
+	"os"
+	"strings"
+)
+
+func detectOverlayfs() bool {
+	data, err := os.ReadFile("/proc/filesystems")
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasSuffix(strings.TrimSpace(line), "overlay") {
+			return true
+		}
+	}
+	return false
+}
+
+func detectUserNamespaces() bool {
+	if _, err := os.Stat("/proc/self/ns/user"); err != nil {
+		return false
+	}
+	data, err := os.ReadFile("/proc/sys/kernel/unprivileged_userns_clone")
+	if err != nil {
+		// Kernels without the sysctl (it's Debian/Ubuntu-specific) allow
+		// unprivileged user namespaces unconditionally, so the namespace
+		// existing is enough.
+		return true
+	}
+	return strings.TrimSpace(string(data)) != "0"
+}
+
+func detectFuse() bool {
+	_, err := os.Stat("/dev/fuse")
+	return err == nil
+}
+
+func detectCgroups() bool {
+	if _, err := os.Stat("/sys/fs/cgroup/cgroup.controllers"); err == nil {
+		return true
+	}
+	_, err := os.Stat("/sys/fs/cgroup/memory")
+	return err == nil
+}
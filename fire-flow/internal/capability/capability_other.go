@@ -0,0 +1,14 @@
+//go:build !linux
+
+package capability
+
+// Overlay mounts, user namespaces, FUSE, and cgroups are Linux-specific;
+// fire-flow's non-Linux support is limited to the fallback code paths
+// that already handle their absence (see internal/overlay/mounterror_linux.go
+// and friends), so these simply report unavailable rather than probing
+// for platform equivalents that don't exist yet.
+
+func detectOverlayfs() bool      { return false }
+func detectUserNamespaces() bool { return false }
+func detectFuse() bool           { return false }
+func detectCgroups() bool        { return false }
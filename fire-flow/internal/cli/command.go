@@ -0,0 +1,12 @@
+// Package cli holds the small subcommand-dispatch scaffolding shared by
+// every fire-flow subcommand.
+package cli
+
+// Command is a single fire-flow subcommand (mutate, run-tests, next-bead,
+// serve, doctor, ...). Each subcommand owns its own flag
+// parsing so subcommands can evolve independently of one another.
+type Command interface {
+	Name() string
+	Synopsis() string
+	Run(args []string) error
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+)
+
+// Middleware wraps a Command to add cross-cutting behavior (panic
+// recovery, locking, auditing, timing, dry-run interception) without
+// each subcommand implementing it separately.
+type Middleware func(Command) Command
+
+// Chain wraps cmd with each of mws in order, so the first middleware
+// listed is outermost (runs first on the way in, last on the way out).
+func Chain(cmd Command, mws ...Middleware) Command {
+	for i := len(mws) - 1; i >= 0; i-- {
+		cmd = mws[i](cmd)
+	}
+	return cmd
+}
+
+// funcCommand adapts a name/synopsis pair and a Run func into a Command,
+// so a middleware can build its wrapped command without a named type per
+// middleware.
+type funcCommand struct {
+	name     string
+	synopsis string
+	run      func(args []string) error
+}
+
+func (f funcCommand) Name() string             { return f.name }
+func (f funcCommand) Synopsis() string         { return f.synopsis }
+func (f funcCommand) Run(args []string) error  { return f.run(args) }
+func wrap(cmd Command, run func(args []string) error) Command {
+	return funcCommand{name: cmd.Name(), synopsis: cmd.Synopsis(), run: run}
+}
+
+// Recover converts a panic during Run into an error, so one command's
+// bug can't take down a long-running daemon loop that dispatches many
+// commands in the same process.
+func Recover() Middleware {
+	return func(cmd Command) Command {
+		return wrap(cmd, func(args []string) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("command %s panicked: %v", cmd.Name(), r)
+				}
+			}()
+			return cmd.Run(args)
+		})
+	}
+}
+
+// Timing calls report with the elapsed duration after Run returns,
+// whether it succeeded or failed, for a metrics sink or log line.
+func Timing(report func(name string, elapsed time.Duration)) Middleware {
+	return func(cmd Command) Command {
+		return wrap(cmd, func(args []string) error {
+			start := time.Now()
+			err := cmd.Run(args)
+			if report != nil {
+				report(cmd.Name(), time.Since(start))
+			}
+			return err
+		})
+	}
+}
+
+// AuditEntry records one command invocation for a compliance/security
+// log.
+type AuditEntry struct {
+	Command string
+	Args    []string
+	At      time.Time
+	Err     string // empty on success
+}
+
+// Audit calls record with an AuditEntry after every invocation of cmd.
+func Audit(record func(AuditEntry)) Middleware {
+	return func(cmd Command) Command {
+		return wrap(cmd, func(args []string) error {
+			entry := AuditEntry{Command: cmd.Name(), Args: args, At: time.Now()}
+			err := cmd.Run(args)
+			if err != nil {
+				entry.Err = err.Error()
+			}
+			if record != nil {
+				record(entry)
+			}
+			return err
+		})
+	}
+}
+
+// Lock only runs cmd while holding the lock acquire returns, releasing
+// it once Run finishes. Pair with something like gitops.Serialize's
+// per-repo mutex, generalized here to any command that touches shared
+// state (the project's state.json, a shared beads file, ...).
+func Lock(acquire func() (release func(), err error)) Middleware {
+	return func(cmd Command) Command {
+		return wrap(cmd, func(args []string) error {
+			release, err := acquire()
+			if err != nil {
+				return fmt.Errorf("acquiring lock for command %s: %w", cmd.Name(), err)
+			}
+			defer release()
+			return cmd.Run(args)
+		})
+	}
+}
+
+// DryRun intercepts Run when enabled reports true at call time, calling
+// report with the command and its args instead of actually running it.
+func DryRun(enabled func() bool, report func(name string, args []string)) Middleware {
+	return func(cmd Command) Command {
+		return wrap(cmd, func(args []string) error {
+			if enabled != nil && enabled() {
+				if report != nil {
+					report(cmd.Name(), args)
+				}
+				return nil
+			}
+			return cmd.Run(args)
+		})
+	}
+}
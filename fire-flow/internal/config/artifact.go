@@ -0,0 +1,97 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/lprior-repo/fire-flow/internal/artifact"
+)
+
+// ArtifactConfig binds the "artifacts" section of config.yml, selecting
+// where cycle transcripts, mutation reports, and attestations land.
+type ArtifactConfig struct {
+	// Backend selects which section below applies: "local" (default),
+	// "s3", or "gcs".
+	Backend string      `yaml:"backend"`
+	Local   LocalConfig `yaml:"local"`
+	S3      S3Config    `yaml:"s3"`
+	GCS     GCSConfig   `yaml:"gcs"`
+}
+
+type LocalConfig struct {
+	Root string `yaml:"root"`
+}
+
+type S3Config struct {
+	Bucket    string `yaml:"bucket"`
+	Region    string `yaml:"region"`
+	Endpoint  string `yaml:"endpoint"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+}
+
+type GCSConfig struct {
+	Bucket string `yaml:"bucket"`
+	Token  string `yaml:"token"`
+}
+
+// LoadArtifactConfig reads and parses an artifact config file, falling
+// back to a local store rooted at .opencode/tcr/artifacts when the file
+// doesn't exist.
+func LoadArtifactConfig(path string) (ArtifactConfig, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ArtifactConfig{Backend: "local", Local: LocalConfig{Root: ".opencode/tcr/artifacts"}}, nil
+	}
+	if err != nil {
+		return ArtifactConfig{}, fmt.Errorf("reading artifact config %s: %w", path, err)
+	}
+	cfg, err := ParseArtifactConfig(data)
+	if err != nil {
+		return ArtifactConfig{}, &ParseError{Path: path, Err: err}
+	}
+	return cfg, nil
+}
+
+// ParseArtifactConfig parses artifact config content already in memory.
+func ParseArtifactConfig(data []byte) (ArtifactConfig, error) {
+	var cfg ArtifactConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing artifact config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Store builds the artifact.Store the config selects.
+func (c ArtifactConfig) Store() (artifact.Store, error) {
+	switch c.Backend {
+	case "", "local":
+		root := c.Local.Root
+		if root == "" {
+			root = ".opencode/tcr/artifacts"
+		}
+		return artifact.LocalStore{Root: root}, nil
+	case "s3":
+		if c.S3.Bucket == "" {
+			return nil, fmt.Errorf("artifact config: s3 backend requires a bucket")
+		}
+		return artifact.S3Store{
+			Bucket:    c.S3.Bucket,
+			Region:    c.S3.Region,
+			Endpoint:  c.S3.Endpoint,
+			AccessKey: c.S3.AccessKey,
+			SecretKey: c.S3.SecretKey,
+			HTTP:      http.DefaultClient,
+		}, nil
+	case "gcs":
+		if c.GCS.Bucket == "" {
+			return nil, fmt.Errorf("artifact config: gcs backend requires a bucket")
+		}
+		return artifact.GCSStore{Bucket: c.GCS.Bucket, Token: c.GCS.Token, HTTP: http.DefaultClient}, nil
+	default:
+		return nil, fmt.Errorf("artifact config: unknown backend %q (want local, s3, or gcs)", c.Backend)
+	}
+}
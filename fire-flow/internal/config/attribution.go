@@ -0,0 +1,33 @@
+package config
+
+import "fmt"
+
+// Author is the git identity to commit as, distinct from whatever
+// account the orchestration host itself runs as.
+type Author struct {
+	Name  string `yaml:"name"`
+	Email string `yaml:"email"`
+}
+
+// Attribution maps a bead's assignee to the git identity fire-flow
+// should commit as, so commits from several engineers' beads running on
+// one shared runner host don't all show up under a single generic
+// author.
+type Attribution struct {
+	ByAssignee map[string]Author `yaml:"by_assignee"`
+	Default    Author            `yaml:"default"`
+}
+
+// AuthorFor resolves the git identity for assignee, falling back to
+// Default if assignee has no mapping. An unset Default with an unknown
+// assignee is a configuration error rather than a silent anonymous
+// commit, since attribution is the whole point of this feature.
+func (a Attribution) AuthorFor(assignee string) (Author, error) {
+	if author, ok := a.ByAssignee[assignee]; ok {
+		return author, nil
+	}
+	if a.Default.Name != "" && a.Default.Email != "" {
+		return a.Default, nil
+	}
+	return Author{}, fmt.Errorf("no git identity configured for assignee %q and no default set", assignee)
+}
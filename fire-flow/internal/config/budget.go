@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/lprior-repo/fire-flow/internal/budget"
+)
+
+// BudgetConfig binds budget-config.yaml, the on-disk declaration of the
+// concurrency pool every parallel subsystem (tests, mutation, AI
+// workers, commits) shares.
+type BudgetConfig struct {
+	// Slots is the pool's total concurrent-job capacity. 0 means use
+	// budget.DefaultSize (one slot per logical CPU).
+	Slots int `yaml:"slots"`
+}
+
+// LoadBudgetConfig reads and parses a budget config file, returning the
+// zero-value config (which Pool resolves to budget.DefaultSize) when the
+// file doesn't exist.
+func LoadBudgetConfig(path string) (BudgetConfig, error) {
+	var cfg BudgetConfig
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("reading budget config %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, &ParseError{Path: path, Err: err}
+	}
+	return cfg, nil
+}
+
+// Pool builds the shared budget.Pool this config describes.
+func (c BudgetConfig) Pool() *budget.Pool {
+	if c.Slots <= 0 {
+		return budget.NewPool(budget.DefaultSize())
+	}
+	return budget.NewPool(c.Slots)
+}
@@ -0,0 +1,33 @@
+package config
+
+import "github.com/lprior-repo/fire-flow/internal/vendored"
+
+// CommitConfig configures how overlay changes are merged into the lower
+// dir.
+type CommitConfig struct {
+	// CommitIgnore lists glob patterns (filepath.Match syntax) for paths
+	// that Commit and the diff command should never merge down, such as
+	// build artifacts an AI run generates inside the overlay (bin/,
+	// coverage.out, node_modules) and vendored directories.
+	CommitIgnore []string `yaml:"commit_ignore"`
+	// CommitAllow overrides CommitIgnore for paths that also match it,
+	// e.g. so a project can commit vendor/ when a bead's whole purpose
+	// is running `go mod vendor`.
+	CommitAllow []string `yaml:"commit_allow"`
+}
+
+// DefaultCommitConfig returns the ignore patterns fire-flow applies out
+// of the box, covering the most common accidental commits across the
+// languages this repo already supports (Rust, Go, Nushell, TypeScript),
+// plus vendored directories (see internal/vendored).
+func DefaultCommitConfig() CommitConfig {
+	return CommitConfig{
+		CommitIgnore: append([]string{
+			"target",
+			"bin",
+			"node_modules",
+			"coverage.out",
+			"*.rlib",
+		}, vendored.DefaultPatterns...),
+	}
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Alias maps a deprecated dotted config key to the path it was renamed
+// to, so a project's existing config file keeps working (with a
+// deprecation warning) instead of silently losing the setting or
+// failing to parse.
+type Alias struct {
+	Old string
+	New string
+}
+
+// Aliases is the table LoadGateConfig and LoadPipelineConfig check
+// before unmarshaling; the other Load* functions in this package do not
+// apply it. Add an entry here when renaming a config key
+// instead of breaking projects still on the old name; remove it once
+// the old name has been deprecated long enough to drop.
+var Aliases []Alias
+
+// ApplyAliases rewrites any deprecated key present in data to its
+// replacement path (via Aliases), returning the rewritten YAML and one
+// warning per old key found so the caller can log it. A key already set
+// at its new path wins over an old one — ApplyAliases only fills the new
+// path in when it's absent, never overwrites an explicit modern value.
+func ApplyAliases(data []byte) ([]byte, []string, error) {
+	var generic map[string]any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return data, nil, fmt.Errorf("decoding config for alias rewrite: %w", err)
+	}
+	if generic == nil || len(Aliases) == 0 {
+		return data, nil, nil
+	}
+
+	var warnings []string
+	for _, alias := range Aliases {
+		oldPath := strings.Split(alias.Old, ".")
+		value, ok := getPath(generic, oldPath)
+		if !ok {
+			continue
+		}
+		newPath := strings.Split(alias.New, ".")
+		if _, exists := getPath(generic, newPath); exists {
+			continue
+		}
+		if err := setPath(generic, newPath, value); err != nil {
+			return data, warnings, fmt.Errorf("rewriting deprecated key %s to %s: %w", alias.Old, alias.New, err)
+		}
+		deletePath(generic, oldPath)
+		warnings = append(warnings, fmt.Sprintf("config key %q is deprecated, use %q instead", alias.Old, alias.New))
+	}
+	if len(warnings) == 0 {
+		return data, nil, nil
+	}
+
+	rewritten, err := yaml.Marshal(generic)
+	if err != nil {
+		return data, warnings, fmt.Errorf("re-marshaling config after alias rewrite: %w", err)
+	}
+	return rewritten, warnings, nil
+}
+
+func getPath(m map[string]any, path []string) (any, bool) {
+	child, ok := m[path[0]]
+	if !ok {
+		return nil, false
+	}
+	if len(path) == 1 {
+		return child, true
+	}
+	childMap, ok := child.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return getPath(childMap, path[1:])
+}
+
+func deletePath(m map[string]any, path []string) {
+	if len(path) == 1 {
+		delete(m, path[0])
+		return
+	}
+	child, ok := m[path[0]]
+	if !ok {
+		return
+	}
+	if childMap, ok := child.(map[string]any); ok {
+		deletePath(childMap, path[1:])
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidOverride means a --set flag wasn't in key=value form.
+var ErrInvalidOverride = errors.New("invalid override, expected key=value")
+
+// ParseError wraps a config file that was read successfully but failed
+// to parse as YAML, so callers (and the CLI's exit-code mapper) can tell
+// "malformed config" apart from "file missing" or "file unreadable"
+// with errors.As instead of matching on the message.
+type ParseError struct {
+	Path string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing config %s: %v", e.Path, e.Err)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
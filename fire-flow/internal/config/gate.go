@@ -0,0 +1,204 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/lprior-repo/fire-flow/internal/depcheck"
+	"github.com/lprior-repo/fire-flow/internal/gate"
+	"github.com/lprior-repo/fire-flow/internal/licenseheader"
+	"github.com/lprior-repo/fire-flow/internal/secretscan"
+	"github.com/lprior-repo/fire-flow/internal/vulncheck"
+)
+
+// GateConfig binds gate-config.yaml, the on-disk declaration of the
+// rule DSL and external gate checks that run before a cycle is allowed
+// to commit.
+type GateConfig struct {
+	Rules  []gate.Rule       `yaml:"rules"`
+	Checks []pluginCheckYAML `yaml:"checks"`
+	// DocPatterns overrides gate.DefaultDocPatterns for the doc-only
+	// fast path; leave unset to use the defaults.
+	DocPatterns []string `yaml:"doc_patterns"`
+	// VulnCheck configures the optional govulncheck gate stage; leave
+	// unset (or Enabled: false) to skip it entirely.
+	VulnCheck vulnCheckYAML `yaml:"vuln_check"`
+	// SecretScan configures the credential-scanning gate stage.
+	SecretScan secretScanYAML `yaml:"secret_scan"`
+	// LicenseHeader configures the license-header enforcement stage.
+	LicenseHeader licenseHeaderYAML `yaml:"license_header"`
+	// DepCheck configures the go.mod dependency policy stage.
+	DepCheck depCheckYAML `yaml:"dep_check"`
+}
+
+// depCheckYAML binds the dep_check section of gate-config.yaml.
+type depCheckYAML struct {
+	Enabled   bool     `yaml:"enabled"`
+	ScanVulns bool     `yaml:"scan_vulns"`
+	Allow     []string `yaml:"allow"`
+	Deny      []string `yaml:"deny"`
+}
+
+// vulnCheckYAML binds the vuln_check section of gate-config.yaml.
+type vulnCheckYAML struct {
+	Enabled  bool     `yaml:"enabled"`
+	CacheDir string   `yaml:"cache_dir"`
+	Packages []string `yaml:"packages"`
+}
+
+// secretScanYAML binds the secret_scan section of gate-config.yaml.
+type secretScanYAML struct {
+	Enabled bool `yaml:"enabled"`
+	// ExcludePatterns are glob patterns (filepath.Match against both the
+	// full path and its base name) for paths that legitimately
+	// contain high-entropy or key-shaped content, e.g. test fixtures.
+	ExcludePatterns []string `yaml:"exclude_patterns"`
+}
+
+// licenseHeaderYAML binds the license_header section of
+// gate-config.yaml. Templates maps a file extension (".go", ".py", ...)
+// to the literal header text required at the top of every matching file.
+type licenseHeaderYAML struct {
+	Enabled    bool              `yaml:"enabled"`
+	AutoInsert bool              `yaml:"auto_insert"`
+	Templates  map[string]string `yaml:"templates"`
+}
+
+// pluginCheckYAML mirrors gate.PluginCheck but with a string timeout,
+// since YAML has no native time.Duration.
+type pluginCheckYAML struct {
+	Name    string   `yaml:"name"`
+	Path    string   `yaml:"path"`
+	Args    []string `yaml:"args"`
+	Timeout string   `yaml:"timeout"`
+}
+
+// LoadGateConfig reads and parses a gate config file, returning no
+// checks (an always-allow gate) when the file doesn't exist. Deprecated
+// keys (see Aliases) are rewritten to their current name; each rewrite
+// is reported in warnings for the caller to log.
+func LoadGateConfig(path string) (cfg GateConfig, warnings []string, err error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil, nil
+	}
+	if err != nil {
+		return cfg, nil, fmt.Errorf("reading gate config %s: %w", path, err)
+	}
+	data, warnings, err = ApplyAliases(data)
+	if err != nil {
+		return cfg, nil, &ParseError{Path: path, Err: err}
+	}
+	cfg, err = ParseGateConfig(data)
+	if err != nil {
+		return cfg, warnings, &ParseError{Path: path, Err: err}
+	}
+	return cfg, warnings, nil
+}
+
+// ParseGateConfig parses gate-config.yaml content already in memory,
+// e.g. a config snapshot recorded by internal/replay for later replay
+// rather than one just read off disk.
+func ParseGateConfig(data []byte) (GateConfig, error) {
+	var cfg GateConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing gate config: %w", err)
+	}
+	return cfg, nil
+}
+
+// PluginChecks converts the YAML-friendly check list into gate.PluginCheck
+// values, parsing each timeout string.
+func (c GateConfig) PluginChecks() ([]gate.PluginCheck, error) {
+	checks := make([]gate.PluginCheck, 0, len(c.Checks))
+	for _, raw := range c.Checks {
+		var timeout time.Duration
+		if raw.Timeout != "" {
+			d, err := time.ParseDuration(raw.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("parsing timeout for gate check %s: %w", raw.Name, err)
+			}
+			timeout = d
+		}
+		checks = append(checks, gate.PluginCheck{
+			Name:    raw.Name,
+			Path:    raw.Path,
+			Args:    raw.Args,
+			Timeout: timeout,
+		})
+	}
+	return checks, nil
+}
+
+// VulnScanner returns the configured vulncheck.Scanner and the packages
+// to scan, along with whether the stage is enabled at all. Packages
+// defaults to scanning everything ("./...") when unset.
+func (c GateConfig) VulnScanner() (scanner vulncheck.Scanner, packages []string, enabled bool) {
+	if !c.VulnCheck.Enabled {
+		return vulncheck.Scanner{}, nil, false
+	}
+	packages = c.VulnCheck.Packages
+	if len(packages) == 0 {
+		packages = []string{"./..."}
+	}
+	return vulncheck.Scanner{CacheDir: c.VulnCheck.CacheDir}, packages, true
+}
+
+// ScanForSecrets runs the secret-scan stage over rel (paths relative to
+// baseDir, as returned by overlay.Diff) when the stage is enabled,
+// skipping any path matching an ExcludePatterns glob.
+func (c GateConfig) ScanForSecrets(baseDir string, rel []string) ([]secretscan.Finding, error) {
+	if !c.SecretScan.Enabled {
+		return nil, nil
+	}
+	filtered := make([]string, 0, len(rel))
+	for _, r := range rel {
+		if !ignoredBySecretScan(r, c.SecretScan.ExcludePatterns) {
+			filtered = append(filtered, r)
+		}
+	}
+	return secretscan.ScanFiles(baseDir, filtered)
+}
+
+// EnforceLicenseHeaders runs the license-header stage over rel (paths
+// relative to baseDir, as returned by overlay.Diff) when the stage is
+// enabled, returning licenseheader.Result unchanged so the caller can
+// tell inserted files (already fixed) apart from remaining violations.
+func (c GateConfig) EnforceLicenseHeaders(baseDir string, rel []string) (licenseheader.Result, error) {
+	if !c.LicenseHeader.Enabled {
+		return licenseheader.Result{}, nil
+	}
+	return licenseheader.Apply(baseDir, rel, licenseheader.Policy{
+		Templates:  c.LicenseHeader.Templates,
+		AutoInsert: c.LicenseHeader.AutoInsert,
+	})
+}
+
+// CheckDependencies runs the dep-check stage over a go.mod diff when the
+// stage is enabled, denying the commit if any newly added module
+// violates the configured allow/deny policy (and, when ScanVulns is
+// set, if OSV reports a vulnerability for one).
+func (c GateConfig) CheckDependencies(oldGoMod, newGoMod string) depcheck.Verdict {
+	if !c.DepCheck.Enabled {
+		return depcheck.Verdict{Allow: true}
+	}
+	policy := depcheck.Policy{Allow: c.DepCheck.Allow, Deny: c.DepCheck.Deny}
+	return depcheck.CheckGoModDiff(oldGoMod, newGoMod, policy, c.DepCheck.ScanVulns, http.DefaultClient)
+}
+
+func ignoredBySecretScan(rel string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, rel); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(p, filepath.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MutationConfig binds mutation-test-config.yaml, the on-disk config for
+// `fire-flow mutate`.
+type MutationConfig struct {
+	Packages    []string `yaml:"packages"`
+	Concurrency int      `yaml:"concurrency"`
+	ScratchDir  string   `yaml:"scratch_dir"`
+	// MinScore is the minimum acceptable mutation score (killed/total,
+	// in [0,1]); `fire-flow mutate` exits non-zero when the observed
+	// score falls below it.
+	MinScore float64 `yaml:"min_score"`
+}
+
+// DefaultMutationConfig returns the config used when mutation-test-config.yaml
+// is absent.
+func DefaultMutationConfig() MutationConfig {
+	return MutationConfig{
+		Concurrency: 4,
+		ScratchDir:  ".fire-flow/mutate",
+		MinScore:    0.6,
+	}
+}
+
+// LoadMutationConfig reads and parses a mutation config file, falling
+// back to defaults for any field it doesn't set and for the whole file
+// when it doesn't exist.
+func LoadMutationConfig(path string) (MutationConfig, error) {
+	cfg := DefaultMutationConfig()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("reading mutation config %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, &ParseError{Path: path, Err: err}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Overrides is a set of ad hoc `--set key=value` flags, viper-style,
+// applied after the file config layer so a one-off CLI
+// invocation can tweak a single field without editing the config file.
+type Overrides map[string]string
+
+// ParseSetFlags parses repeated "-set key=value" flag values into
+// Overrides. Dotted keys (e.g. "quiet_hours.start_hour") address nested
+// fields.
+func ParseSetFlags(values []string) (Overrides, error) {
+	o := make(Overrides, len(values))
+	for _, v := range values {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q: %w", v, ErrInvalidOverride)
+		}
+		o[strings.TrimSpace(key)] = value
+	}
+	return o, nil
+}
+
+// Apply overlays o onto cfg (a pointer to a yaml-tagged config struct) by
+// round-tripping through a generic map: marshal cfg to yaml, decode into
+// a map, set each override by its dotted path, then unmarshal back into
+// cfg. This lets --set work against any config struct without each one
+// needing its own override plumbing.
+func Apply(cfg any, o Overrides) error {
+	if len(o) == 0 {
+		return nil
+	}
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("marshaling config for override: %w", err)
+	}
+	var generic map[string]any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return fmt.Errorf("decoding config for override: %w", err)
+	}
+	if generic == nil {
+		generic = map[string]any{}
+	}
+	for key, value := range o {
+		if err := setPath(generic, strings.Split(key, "."), parseScalar(value)); err != nil {
+			return fmt.Errorf("applying --set %s: %w", key, err)
+		}
+	}
+	merged, err := yaml.Marshal(generic)
+	if err != nil {
+		return fmt.Errorf("re-marshaling config after override: %w", err)
+	}
+	if err := yaml.Unmarshal(merged, cfg); err != nil {
+		return fmt.Errorf("applying overrides back onto config: %w", err)
+	}
+	return nil
+}
+
+func setPath(m map[string]any, path []string, value any) error {
+	key := path[0]
+	if len(path) == 1 {
+		m[key] = value
+		return nil
+	}
+	child, ok := m[key]
+	if !ok {
+		child = map[string]any{}
+		m[key] = child
+	}
+	childMap, ok := child.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%s is not a nested field", key)
+	}
+	return setPath(childMap, path[1:], value)
+}
+
+// parseScalar converts a flag string into the most specific YAML scalar
+// it looks like, so `--set concurrency=8` sets an int field, not the
+// string "8".
+func parseScalar(s string) any {
+	if b, err := strconv.ParseBool(s); err == nil {
+		return b
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
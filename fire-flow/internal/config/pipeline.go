@@ -0,0 +1,95 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/lprior-repo/fire-flow/internal/pipeline"
+)
+
+// PipelineConfig binds pipeline-config.yaml, the on-disk declaration of
+// the cycle's stage order. A missing file falls back to
+// pipeline.DefaultStages, fire-flow's built-in gate -> lint -> test ->
+// mutation -> policy -> commit -> push sequence.
+type PipelineConfig struct {
+	StageList []stageYAML `yaml:"stages"`
+}
+
+// stageYAML mirrors pipeline.Stage but with a string timeout, since YAML
+// has no native time.Duration.
+type stageYAML struct {
+	Name         string   `yaml:"name"`
+	Enabled      bool     `yaml:"enabled"`
+	Timeout      string   `yaml:"timeout"`
+	AllowFailure bool     `yaml:"allow_failure"`
+	SkipUnless   []string `yaml:"skip_unless_changed"`
+}
+
+// LoadPipelineConfig reads and parses a pipeline config file, returning
+// pipeline.DefaultStages when the file doesn't exist. Deprecated keys
+// (see Aliases) are rewritten to their current name; each rewrite is
+// reported in warnings for the caller to log.
+func LoadPipelineConfig(path string) (cfg PipelineConfig, warnings []string, err error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return defaultPipelineConfig(), nil, nil
+	}
+	if err != nil {
+		return PipelineConfig{}, nil, fmt.Errorf("reading pipeline config %s: %w", path, err)
+	}
+	data, warnings, err = ApplyAliases(data)
+	if err != nil {
+		return cfg, nil, &ParseError{Path: path, Err: err}
+	}
+	cfg, err = ParsePipelineConfig(data)
+	if err != nil {
+		return cfg, warnings, &ParseError{Path: path, Err: err}
+	}
+	return cfg, warnings, nil
+}
+
+// ParsePipelineConfig parses pipeline-config.yaml content already in
+// memory, e.g. a config snapshot recorded by internal/replay.
+func ParsePipelineConfig(data []byte) (PipelineConfig, error) {
+	var cfg PipelineConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing pipeline config: %w", err)
+	}
+	return cfg, nil
+}
+
+func defaultPipelineConfig() PipelineConfig {
+	defaults := pipeline.DefaultStages()
+	cfg := PipelineConfig{StageList: make([]stageYAML, len(defaults))}
+	for i, s := range defaults {
+		cfg.StageList[i] = stageYAML{Name: string(s.Name), Enabled: s.Enabled}
+	}
+	return cfg
+}
+
+// Stages converts the YAML-friendly stage list into pipeline.Stage
+// values, parsing each timeout string.
+func (c PipelineConfig) Stages() ([]pipeline.Stage, error) {
+	stages := make([]pipeline.Stage, 0, len(c.StageList))
+	for _, raw := range c.StageList {
+		var timeout time.Duration
+		if raw.Timeout != "" {
+			d, err := time.ParseDuration(raw.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("parsing timeout for pipeline stage %s: %w", raw.Name, err)
+			}
+			timeout = d
+		}
+		stages = append(stages, pipeline.Stage{
+			Name:              pipeline.StageName(raw.Name),
+			Enabled:           raw.Enabled,
+			Timeout:           timeout,
+			AllowFailure:      raw.AllowFailure,
+			SkipUnlessChanged: raw.SkipUnless,
+		})
+	}
+	return stages, nil
+}
@@ -0,0 +1,10 @@
+package config
+
+// Quarantine lists tests a project has flagged as known-broken, so
+// internal/baseline's quarantine-aware GREEN check doesn't treat them as
+// this attempt's fault. Distinct from the baseline itself: quarantine is
+// a durable, hand-maintained allowlist, while the baseline is recorded
+// fresh per workspace.
+type Quarantine struct {
+	Tests []string `yaml:"tests"`
+}
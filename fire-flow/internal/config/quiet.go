@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// QuietHours disables automatic AI runs or pushes during a configured
+// window (e.g. outside work hours, so pushes land while a human is
+// around to review them). Beads finished during quiet hours are queued
+// instead of pushed; `fire-flow flush` releases the queue.
+type QuietHours struct {
+	Enabled   bool   `yaml:"enabled"`
+	StartHour int    `yaml:"start_hour"` // 0-23, local time
+	EndHour   int    `yaml:"end_hour"`   // 0-23, local time; wraps past midnight if < StartHour
+	Timezone  string `yaml:"timezone"`   // IANA name; empty means local
+}
+
+// Active reports whether t falls inside the configured quiet window.
+func (q QuietHours) Active(t time.Time) (bool, error) {
+	if !q.Enabled {
+		return false, nil
+	}
+	if q.Timezone != "" {
+		loc, err := time.LoadLocation(q.Timezone)
+		if err != nil {
+			return false, fmt.Errorf("loading quiet hours timezone %q: %w", q.Timezone, err)
+		}
+		t = t.In(loc)
+	}
+	hour := t.Hour()
+	if q.StartHour <= q.EndHour {
+		return hour >= q.StartHour && hour < q.EndHour, nil
+	}
+	// Window wraps past midnight, e.g. 20 -> 6.
+	return hour >= q.StartHour || hour < q.EndHour, nil
+}
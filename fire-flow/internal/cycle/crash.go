@@ -0,0 +1,85 @@
+package cycle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+)
+
+// CrashReport captures enough context to diagnose a panic mid-cycle
+// without a human needing to reproduce it: the stack trace, the state
+// the cycle was in, and the events leading up to it.
+type CrashReport struct {
+	BeadID        string    `json:"bead_id"`
+	Panic         string    `json:"panic"`
+	Stack         string    `json:"stack"`
+	StateSnapshot any       `json:"state_snapshot"`
+	RecentEvents  []string  `json:"recent_events"`
+	Time          time.Time `json:"time"`
+}
+
+// EmergencyCleanup is supplied by the caller to unmount/discard whatever
+// overlay session was live when the panic happened; Recover calls it
+// before writing the crash report so a wedged mount doesn't also block
+// diagnosis.
+type EmergencyCleanup func() error
+
+// Recover should be deferred at the top of any cycle-running goroutine.
+// On panic, it runs cleanup, writes a crash report under
+// crashDir/<timestamp>-<beadID>.json, and marks the bead needs-attention
+// via markNeedsAttention (typically bead.AppendProposal). It deliberately
+// does not re-panic: fire-flow treats an unhandled cycle panic as a
+// recoverable infra error, not a process-ending one, so the daemon can
+// keep serving other projects.
+func Recover(beadID, crashDir string, recentEvents []string, stateSnapshot any, cleanup EmergencyCleanup, markNeedsAttention func(reason string) error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if cleanup != nil {
+		if err := cleanup(); err != nil {
+			fmt.Fprintf(os.Stderr, "fire-flow: emergency cleanup after panic also failed: %v\n", err)
+		}
+	}
+
+	report := CrashReport{
+		BeadID:        beadID,
+		Panic:         fmt.Sprint(r),
+		Stack:         string(debug.Stack()),
+		StateSnapshot: stateSnapshot,
+		RecentEvents:  recentEvents,
+		Time:          time.Now().UTC(),
+	}
+	path, writeErr := writeCrashReport(crashDir, report)
+	if writeErr != nil {
+		fmt.Fprintf(os.Stderr, "fire-flow: writing crash report failed: %v\n", writeErr)
+	} else {
+		fmt.Fprintf(os.Stderr, "fire-flow: bead %s panicked; crash report at %s\n", beadID, path)
+	}
+
+	if markNeedsAttention != nil {
+		if err := markNeedsAttention(fmt.Sprintf("panic during cycle: %v", r)); err != nil {
+			fmt.Fprintf(os.Stderr, "fire-flow: marking bead %s needs-attention failed: %v\n", beadID, err)
+		}
+	}
+}
+
+func writeCrashReport(crashDir string, report CrashReport) (string, error) {
+	if err := os.MkdirAll(crashDir, 0o755); err != nil {
+		return "", fmt.Errorf("creating crash dir %s: %w", crashDir, err)
+	}
+	name := fmt.Sprintf("%s-%s.json", report.Time.Format("20060102T150405Z"), report.BeadID)
+	path := filepath.Join(crashDir, name)
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling crash report: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("writing crash report %s: %w", path, err)
+	}
+	return path, nil
+}
@@ -0,0 +1,13 @@
+// Package cycle records the outcome of a single TCR cycle (test, then
+// commit or revert) in a form CI can consume: a documented exit code plus
+// an optional machine-readable summary file.
+package cycle
+
+// Exit codes are a stable contract for CI: scripts can branch on the
+// numeric value without parsing fire-flow's human-readable output.
+const (
+	ExitGreenCommitted = 0
+	ExitRedReverted    = 2
+	ExitGateBlocked    = 3
+	ExitInfraError     = 10
+)
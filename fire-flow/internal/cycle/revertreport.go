@@ -0,0 +1,73 @@
+package cycle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// RevertReport bundles everything a human needs to understand why an
+// automated cycle reverted, without re-running anything: the failing
+// test output, the diff that got discarded, and the AI's own transcript
+// leading up to the failure.
+type RevertReport struct {
+	BeadID            string    `json:"bead_id"`
+	Time              time.Time `json:"time"`
+	FailingTestOutput string    `json:"failing_test_output"`
+	DiscardedDiff     []string  `json:"discarded_diff"`
+	TranscriptExcerpt string    `json:"transcript_excerpt"`
+}
+
+// maxTranscriptExcerpt caps how much of the AI transcript gets embedded,
+// since a full multi-turn transcript can dwarf the rest of the report;
+// the full transcript is still on disk wherever the AI runner logged it.
+const maxTranscriptExcerpt = 4000
+
+// WriteRevertReport writes report as both markdown (for a human skimming
+// it) and JSON (for tooling) under dir, named by bead and timestamp, and
+// returns the markdown path.
+func WriteRevertReport(dir string, report RevertReport) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("creating revert report dir %s: %w", dir, err)
+	}
+	if len(report.TranscriptExcerpt) > maxTranscriptExcerpt {
+		report.TranscriptExcerpt = report.TranscriptExcerpt[len(report.TranscriptExcerpt)-maxTranscriptExcerpt:]
+	}
+
+	stamp := report.Time.Format("20060102T150405Z")
+	base := fmt.Sprintf("%s-%s", stamp, report.BeadID)
+
+	jsonPath := filepath.Join(dir, base+".json")
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling revert report: %w", err)
+	}
+	if err := os.WriteFile(jsonPath, data, 0o644); err != nil {
+		return "", fmt.Errorf("writing revert report %s: %w", jsonPath, err)
+	}
+
+	mdPath := filepath.Join(dir, base+".md")
+	if err := os.WriteFile(mdPath, []byte(report.markdown()), 0o644); err != nil {
+		return "", fmt.Errorf("writing revert report %s: %w", mdPath, err)
+	}
+	return mdPath, nil
+}
+
+func (r RevertReport) markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Revert report: %s\n\n", r.BeadID)
+	fmt.Fprintf(&b, "_%s_\n\n", r.Time.Format(time.RFC3339))
+	b.WriteString("## Failing tests\n\n```\n")
+	b.WriteString(r.FailingTestOutput)
+	b.WriteString("\n```\n\n## Discarded diff\n\n")
+	for _, f := range r.DiscardedDiff {
+		fmt.Fprintf(&b, "- %s\n", f)
+	}
+	b.WriteString("\n## AI transcript (excerpt)\n\n```\n")
+	b.WriteString(r.TranscriptExcerpt)
+	b.WriteString("\n```\n")
+	return b.String()
+}
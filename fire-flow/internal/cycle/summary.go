@@ -0,0 +1,85 @@
+package cycle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/envinfo"
+)
+
+// Decision is the terminal outcome of a cycle.
+type Decision string
+
+const (
+	DecisionCommitted   Decision = "committed"
+	DecisionReverted    Decision = "reverted"
+	DecisionGateBlocked Decision = "gate_blocked"
+	DecisionInfraError  Decision = "infra_error"
+	// DecisionDocsOnly marks a commit made via the gate's doc-only fast
+	// path: no RED state and no test run were required, so it's kept
+	// distinct from DecisionCommitted in history and digests.
+	DecisionDocsOnly Decision = "docs_only"
+)
+
+// Summary is the complete machine-readable record of one cycle, written
+// to --summary-file for CI to archive or assert against.
+type Summary struct {
+	BeadID      string    `json:"bead_id"`
+	StateBefore string    `json:"state_before"`
+	StateAfter  string    `json:"state_after"`
+	TestsPassed int       `json:"tests_passed"`
+	TestsFailed int       `json:"tests_failed"`
+	Duration    string    `json:"duration"`
+	Decision    Decision  `json:"decision"`
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+	// VulnFindings lists reachable vulnerabilities the optional
+	// govulncheck gate stage reported (see internal/vulncheck), one
+	// entry per finding as "<osv id> in <package>". Empty when the
+	// stage wasn't configured or found nothing.
+	VulnFindings []string `json:"vuln_findings,omitempty"`
+	// SecretFindings lists suspected credentials the secret-scan gate
+	// stage reported (see internal/secretscan), one entry per finding as
+	// "<file>:<line> (<rule>)". Empty when the stage wasn't configured
+	// or found nothing.
+	SecretFindings []string `json:"secret_findings,omitempty"`
+	// LicenseHeadersInserted lists files the license-header gate stage
+	// (see internal/licenseheader) auto-inserted a header into.
+	LicenseHeadersInserted []string `json:"license_headers_inserted,omitempty"`
+	// LicenseHeaderViolations lists files missing a required license
+	// header when auto-insertion wasn't enabled.
+	LicenseHeaderViolations []string `json:"license_header_violations,omitempty"`
+	// Environment records the workspace's tool versions, OS/kernel, and
+	// relevant env vars (see internal/envinfo), so a result difference
+	// between runner machines can be traced to environment drift instead
+	// of guessed at. Nil when the caller didn't capture one.
+	Environment *envinfo.Snapshot `json:"environment,omitempty"`
+}
+
+// ExitCode maps a Decision to its documented exit code.
+func (s Summary) ExitCode() int {
+	switch s.Decision {
+	case DecisionCommitted, DecisionDocsOnly:
+		return ExitGreenCommitted
+	case DecisionReverted:
+		return ExitRedReverted
+	case DecisionGateBlocked:
+		return ExitGateBlocked
+	default:
+		return ExitInfraError
+	}
+}
+
+// WriteFile writes the summary as indented JSON to path.
+func (s Summary) WriteFile(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling cycle summary: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing cycle summary to %s: %w", path, err)
+	}
+	return nil
+}
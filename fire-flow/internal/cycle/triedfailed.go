@@ -0,0 +1,107 @@
+package cycle
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// TriedApproach is a compact record of one reverted attempt at a bead:
+// enough for a future prompt to recognize "we already tried this" and
+// steer away from it, without re-embedding the full discarded diff.
+type TriedApproach struct {
+	Summary      string    `json:"summary"`
+	FailingTests []string  `json:"failing_tests"`
+	RevertedAt   time.Time `json:"reverted_at"`
+}
+
+// triedFailedPath returns the per-bead "tried and failed" JSONL file
+// under dir, one file per bead so LoadTriedApproaches never has to scan
+// records for beads it doesn't care about.
+func triedFailedPath(dir, beadID string) string {
+	return filepath.Join(dir, beadID+".jsonl")
+}
+
+// SummarizeApproach turns a discarded diff's file list into a one-line
+// approach summary, cheap enough to compute on every revert without an
+// AI call. It's intentionally coarse (what files, not what changed in
+// them); the failing tests alongside it carry the more useful signal.
+func SummarizeApproach(discardedDiff []string) string {
+	if len(discardedDiff) == 0 {
+		return "no files changed"
+	}
+	return fmt.Sprintf("touched %s", strings.Join(discardedDiff, ", "))
+}
+
+// RecordTriedApproach appends approach to beadID's tried-and-failed log,
+// creating dir and the file as needed.
+func RecordTriedApproach(dir, beadID string, approach TriedApproach) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating tried-approaches dir %s: %w", dir, err)
+	}
+	path := triedFailedPath(dir, beadID)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening tried-approaches log %s: %w", path, err)
+	}
+	defer f.Close()
+	data, err := json.Marshal(approach)
+	if err != nil {
+		return fmt.Errorf("marshaling tried approach: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("appending to tried-approaches log %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadTriedApproaches returns every recorded attempt for beadID, oldest
+// first, or nil if none have reverted yet.
+func LoadTriedApproaches(dir, beadID string) ([]TriedApproach, error) {
+	path := triedFailedPath(dir, beadID)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading tried-approaches log %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var approaches []TriedApproach
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var a TriedApproach
+		if err := json.Unmarshal(scanner.Bytes(), &a); err != nil {
+			return nil, fmt.Errorf("parsing tried approach in %s: %w", path, err)
+		}
+		approaches = append(approaches, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning tried-approaches log %s: %w", path, err)
+	}
+	return approaches, nil
+}
+
+// PromptSection renders approaches for injection into a retry prompt, so
+// the AI sees what's already been tried and failed before generating
+// another attempt.
+func PromptSection(approaches []TriedApproach) string {
+	if len(approaches) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("Approaches already tried and reverted for this bead:\n")
+	for i, a := range approaches {
+		fmt.Fprintf(&b, "%d. %s", i+1, a.Summary)
+		if len(a.FailingTests) > 0 {
+			fmt.Fprintf(&b, " (failed: %s)", strings.Join(a.FailingTests, ", "))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
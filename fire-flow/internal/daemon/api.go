@@ -0,0 +1,221 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/lprior-repo/fire-flow/internal/capability"
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+	"github.com/lprior-repo/fire-flow/internal/idempotency"
+)
+
+// Server exposes the daemon's control API: read-only status for anyone
+// with a token, run-triggering for RoleTrigger and above, and
+// registry mutation for RoleAdmin only. /healthz and /readyz are
+// unauthenticated, matching what systemd/Kestra health probes expect.
+type Server struct {
+	Registry *Registry
+	Tokens   *TokenStore
+	Health   HealthHandler
+
+	// Idempotency records completed /run calls by their Idempotency-Key
+	// header, so a Kestra retry of the same execution returns the
+	// original acknowledgment instead of double-queuing the bead.
+	Idempotency *idempotency.Store
+
+	// Tail fans out live event lines to /tail subscribers; whatever
+	// produces cycle events (the run loop, the AI runner) calls
+	// Tail.Publish with the session name, and this field only needs to
+	// exist for the endpoint to have something to subscribe to ahead of
+	// that wiring landing.
+	Tail *Broadcaster
+
+	// Runner actually kicks off a cycle for a registered project, e.g.
+	// running `fire-flow mutate` (or an epic's --bead-cmd) against
+	// project.RootDir. handleRun calls it synchronously and reports the
+	// outcome; a nil Runner makes /run degrade to acknowledging the
+	// request without doing anything, which is only useful for testing
+	// the API surface in isolation.
+	Runner func(project *Project) error
+}
+
+// NewServer returns a Server ready to have its Handler mounted, wired
+// with a default readiness check that the project registry is
+// reachable. idempotencyPath is where completed /run calls are recorded.
+func NewServer(registry *Registry, tokens *TokenStore, idempotencyPath string) *Server {
+	s := &Server{Registry: registry, Tokens: tokens, Idempotency: idempotency.NewStore(idempotencyPath), Tail: NewBroadcaster()}
+	s.Health = HealthHandler{Ready: []HealthCheck{
+		{Name: "registry", Check: s.checkRegistry},
+	}}
+	return s
+}
+
+// checkRegistry is the daemon's default readiness check: every
+// registered project's state.json must load cleanly, so a corrupt or
+// unreadable state file (internal/state.ErrStateCorrupt, a permissions
+// problem, ...) shows up on /readyz instead of only surfacing the first
+// time something tries to act on that project.
+func (s *Server) checkRegistry() error {
+	for _, name := range s.Registry.List() {
+		p, err := s.Registry.Get(name)
+		if err != nil {
+			return fmt.Errorf("project %s vanished from the registry mid-check: %w", name, err)
+		}
+		if _, err := p.Store.Load(); err != nil {
+			return fmt.Errorf("project %s: loading state: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Handler builds the routed, RBAC-wrapped API handler.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	s.Health.RegisterHealth(mux)
+	mux.Handle("/status", s.Tokens.Require(RoleReadOnly, http.HandlerFunc(s.handleStatus)))
+	mux.Handle("/run", s.Tokens.Require(RoleTrigger, http.HandlerFunc(s.handleRun)))
+	mux.Handle("/admin/projects", s.Tokens.Require(RoleAdmin, http.HandlerFunc(s.handleAdminProjects)))
+	mux.Handle("/tail", s.Tokens.Require(RoleReadOnly, http.HandlerFunc(s.handleTail)))
+	mux.Handle("/capabilities", s.Tokens.Require(RoleReadOnly, http.HandlerFunc(s.handleCapabilities)))
+	return mux
+}
+
+// handleCapabilities reports which host features this daemon detects
+// (capability.Detect probes on its first call, not at startup, and
+// caches the result for the process's lifetime), so a caller can explain behavior differences (e.g. why one
+// runner falls back to a slower overlay backend) without SSH-ing in.
+func (s *Server) handleCapabilities(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, capability.Detect())
+}
+
+// handleTail streams event lines for ?session= as they're published,
+// until the client disconnects. Line-delimited plain text rather than
+// JSON, so a client can pipe the response straight to a terminal.
+func (s *Server) handleTail(w http.ResponseWriter, r *http.Request) {
+	session := r.URL.Query().Get("session")
+	if session == "" {
+		http.Error(w, "session is required", http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	lines, cancel := s.Tail.Subscribe(session)
+	defer cancel()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case line, ok := <-lines:
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	names := s.Registry.List()
+	statuses := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		p, err := s.Registry.Get(name)
+		if err != nil {
+			continue
+		}
+		st, err := p.Store.Load()
+		if err != nil {
+			statuses = append(statuses, map[string]any{"name": name, "error": err.Error()})
+			continue
+		}
+		statuses = append(statuses, map[string]any{"name": name, "mode": st.Mode, "paused": st.Paused, "stats": st.Stats, "outbox_pending": st.OutboxPending})
+	}
+	writeJSON(w, statuses)
+}
+
+func (s *Server) handleRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "POST required", http.StatusMethodNotAllowed)
+		return
+	}
+	name := r.URL.Query().Get("project")
+	project, err := s.Registry.Get(name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if key == "" {
+		// Kestra always retries with the same execution ID; a caller
+		// that omits one is opting out of retry safety, so run it once
+		// without recording anything to dedupe a later retry against.
+		writeJSON(w, s.run(project))
+		return
+	}
+	result, err := idempotency.Once(s.Idempotency, key, func() (map[string]any, error) {
+		return s.run(project), nil
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, result)
+}
+
+// run invokes Runner for project and shapes the result /run responds
+// with. A Runner failure is reported in the response body, not as an
+// idempotency.Once error, so a failed cycle is still recorded: retrying
+// a genuinely broken bead shouldn't re-run it every time Kestra retries.
+func (s *Server) run(project *Project) map[string]any {
+	if s.Runner == nil {
+		return map[string]any{"project": project.Name, "queued": true}
+	}
+	if err := s.runRecovered(project); err != nil {
+		return map[string]any{"project": project.Name, "ran": true, "error": err.Error()}
+	}
+	return map[string]any{"project": project.Name, "ran": true}
+}
+
+// runRecovered calls Runner with cycle.Recover deferred, so a panicking
+// Runner (a cycle blowing up mid-mount, an AI call panicking on a bad
+// response) writes a crash report and comes back as this run's error
+// instead of only killing the HTTP request's goroutine
+// silently, which is all net/http's own per-request recover would do.
+func (s *Server) runRecovered(project *Project) (err error) {
+	crashDir := filepath.Join(project.RootDir, ".opencode", "tcr", "crashes")
+	defer cycle.Recover(project.Name, crashDir, nil, nil, nil, func(reason string) error {
+		err = fmt.Errorf("%s", reason)
+		return nil
+	})
+	return s.Runner(project)
+}
+
+func (s *Server) handleAdminProjects(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, s.Registry.List())
+	case http.MethodDelete:
+		name := r.URL.Query().Get("project")
+		s.Registry.Remove(name)
+		writeJSON(w, map[string]any{"removed": name})
+	default:
+		http.Error(w, "GET or DELETE required", http.StatusMethodNotAllowed)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,87 @@
+package daemon
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lprior-repo/fire-flow/internal/state"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	registry := NewRegistry()
+	if err := registry.Add("demo", t.TempDir(), state.NewStore(filepath.Join(t.TempDir(), "state.json"), 3)); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	tokens := NewTokenStore([]Token{{Value: "trigger-token", Role: RoleTrigger}}, 100, 10)
+	return NewServer(registry, tokens, filepath.Join(t.TempDir(), "idempotency.json"))
+}
+
+func doRun(t *testing.T, s *Server, project string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/run?project="+project, nil)
+	req.Header.Set("Authorization", "Bearer trigger-token")
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandleRunWithoutRunnerAcknowledges(t *testing.T) {
+	s := newTestServer(t)
+	rec := doRun(t, s, "demo")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+}
+
+func TestHandleRunInvokesRunner(t *testing.T) {
+	s := newTestServer(t)
+	var got string
+	s.Runner = func(p *Project) error {
+		got = p.Name
+		return nil
+	}
+	rec := doRun(t, s, "demo")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if got != "demo" {
+		t.Fatalf("Runner called with project %q, want %q", got, "demo")
+	}
+}
+
+func TestHandleRunReportsRunnerFailureWithoutErroringTheRequest(t *testing.T) {
+	s := newTestServer(t)
+	s.Runner = func(p *Project) error { return errors.New("boom") }
+	rec := doRun(t, s, "demo")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if !strings.Contains(rec.Body.String(), "boom") {
+		t.Fatalf("body = %s, want it to mention the runner error", rec.Body.String())
+	}
+}
+
+func TestHandleRunRecoversFromRunnerPanic(t *testing.T) {
+	s := newTestServer(t)
+	s.Runner = func(p *Project) error { panic("mount exploded") }
+	rec := doRun(t, s, "demo")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s, want 200 (a panicking Runner should be recovered, not crash the handler)", rec.Code, rec.Body)
+	}
+	if !strings.Contains(rec.Body.String(), "panic during cycle") {
+		t.Fatalf("body = %s, want it to mention the recovered panic", rec.Body.String())
+	}
+}
+
+func TestHandleRunUnknownProjectIs404(t *testing.T) {
+	s := newTestServer(t)
+	rec := doRun(t, s, "nope")
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+}
@@ -0,0 +1,44 @@
+package daemon
+
+import (
+	"net/http"
+)
+
+// HealthCheck reports one subsystem's health, so /readyz can say exactly
+// what's wrong instead of a bare 500.
+type HealthCheck struct {
+	Name  string
+	Check func() error
+}
+
+// HealthHandler serves /healthz (process is alive) and /readyz (every
+// dependency, e.g. the mount subsystem and beads file access, is
+// working), so systemd and Kestra can restart an unhealthy daemon
+// automatically instead of leaving it wedged.
+type HealthHandler struct {
+	Ready []HealthCheck
+}
+
+// RegisterHealth mounts /healthz and /readyz on mux. /healthz never
+// fails as long as the process can serve HTTP at all; /readyz runs every
+// registered check.
+func (h *HealthHandler) RegisterHealth(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		failures := map[string]string{}
+		for _, c := range h.Ready {
+			if err := c.Check(); err != nil {
+				failures[c.Name] = err.Error()
+			}
+		}
+		if len(failures) > 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			writeJSON(w, map[string]any{"ready": false, "failures": failures})
+			return
+		}
+		writeJSON(w, map[string]any{"ready": true})
+	})
+}
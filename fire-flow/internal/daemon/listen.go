@@ -0,0 +1,85 @@
+package daemon
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// ListenConfig controls how the control API is exposed. The default is
+// a unix domain socket, permission-restricted to the current user;
+// binding to a network address requires explicitly opting in, and
+// binding to a non-loopback address additionally requires TLS.
+type ListenConfig struct {
+	UnixSocket   string // path to a unix socket; takes precedence over Address if set
+	Address      string // "host:port" to listen on instead of a unix socket
+	AllowNetwork bool   // must be true to bind Address at all
+	CertFile     string // TLS cert; required for any non-loopback Address
+	KeyFile      string
+}
+
+// Listen opens the configured listener, refusing plaintext binds to
+// non-loopback addresses unless TLS certs are provided.
+func Listen(cfg ListenConfig) (net.Listener, error) {
+	if cfg.UnixSocket != "" {
+		return listenUnix(cfg.UnixSocket)
+	}
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("no unix socket or address configured for the control API")
+	}
+	if !cfg.AllowNetwork {
+		return nil, fmt.Errorf("binding %s requires AllowNetwork; the control API can mount filesystems and run commands", cfg.Address)
+	}
+	if !isLoopback(cfg.Address) && (cfg.CertFile == "" || cfg.KeyFile == "") {
+		return nil, fmt.Errorf("refusing plaintext bind to non-loopback address %s without TLS certs", cfg.Address)
+	}
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading TLS cert/key: %w", err)
+		}
+		return tls.Listen("tcp", cfg.Address, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	}
+	return net.Listen("tcp", cfg.Address)
+}
+
+func listenUnix(path string) (net.Listener, error) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("removing stale socket %s: %w", path, err)
+	}
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("listening on unix socket %s: %w", path, err)
+	}
+	// Only the owning user can connect; the control API can run
+	// arbitrary commands, so a world- or group-writable socket would be
+	// a local privilege escalation.
+	if err := os.Chmod(path, 0o600); err != nil {
+		return nil, fmt.Errorf("restricting permissions on %s: %w", path, err)
+	}
+	return l, nil
+}
+
+// isLoopback reports whether address names only the local host. An empty
+// host (net.SplitHostPort(":8080") yields host=="") means "bind every
+// interface", which is the opposite of loopback-only, so it must not be
+// treated as safe to serve over plaintext.
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	if host == "" {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	if strings.HasPrefix(host, "127.") || host == "::1" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,42 @@
+package daemon
+
+import "testing"
+
+func TestIsLoopback(t *testing.T) {
+	cases := map[string]bool{
+		"127.0.0.1:8080": true,
+		"localhost:8080": true,
+		"[::1]:8080":     true,
+		":8080":          false, // bind-all, not loopback
+		"0.0.0.0:8080":   false,
+		"10.0.0.5:8080":  false,
+		"example.com:80": false,
+	}
+	for address, want := range cases {
+		if got := isLoopback(address); got != want {
+			t.Errorf("isLoopback(%q) = %v, want %v", address, got, want)
+		}
+	}
+}
+
+func TestListenRefusesPlaintextBindAll(t *testing.T) {
+	_, err := Listen(ListenConfig{Address: ":0", AllowNetwork: true})
+	if err == nil {
+		t.Fatal("Listen with a bind-all address and no TLS certs should fail")
+	}
+}
+
+func TestListenAllowsPlaintextLoopback(t *testing.T) {
+	l, err := Listen(ListenConfig{Address: "127.0.0.1:0", AllowNetwork: true})
+	if err != nil {
+		t.Fatalf("Listen on a loopback address without TLS should succeed: %v", err)
+	}
+	defer l.Close()
+}
+
+func TestListenRequiresAllowNetwork(t *testing.T) {
+	_, err := Listen(ListenConfig{Address: "127.0.0.1:0"})
+	if err == nil {
+		t.Fatal("Listen without AllowNetwork should fail even for a loopback address")
+	}
+}
@@ -0,0 +1,147 @@
+package daemon
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"gopkg.in/yaml.v3"
+)
+
+// Role scopes what a token can do against the control API. The API can
+// mount filesystems and run arbitrary commands, so even read access is a
+// distinct, minimally-privileged role rather than defaulting to open.
+type Role int
+
+const (
+	RoleReadOnly Role = iota
+	RoleTrigger
+	RoleAdmin
+)
+
+// Allows reports whether a token with role r is permitted to call an
+// endpoint that requires need. Roles are ordered least to most
+// privileged, so admin can do everything trigger can, and so on.
+func (r Role) Allows(need Role) bool {
+	return r >= need
+}
+
+// Token is one API credential and the role it grants.
+type Token struct {
+	Value string
+	Role  Role
+}
+
+// TokenStore authenticates bearer tokens and rate-limits each one
+// independently, so one noisy or compromised token can't starve the
+// others.
+type TokenStore struct {
+	mu       sync.Mutex
+	tokens   map[string]Role
+	limiters map[string]*rate.Limiter
+	limit    rate.Limit
+	burst    int
+}
+
+// NewTokenStore returns a TokenStore where every token is limited to
+// ratePerSecond requests/sec with the given burst allowance.
+func NewTokenStore(tokens []Token, ratePerSecond float64, burst int) *TokenStore {
+	s := &TokenStore{
+		tokens:   make(map[string]Role, len(tokens)),
+		limiters: make(map[string]*rate.Limiter, len(tokens)),
+		limit:    rate.Limit(ratePerSecond),
+		burst:    burst,
+	}
+	for _, t := range tokens {
+		s.tokens[t.Value] = t.Role
+	}
+	return s
+}
+
+var roleNames = map[string]Role{"readonly": RoleReadOnly, "trigger": RoleTrigger, "admin": RoleAdmin}
+
+// tokenConfigYAML is one entry of a token config file: it names an
+// environment variable to read the token value from, never the value
+// itself, matching AuthConfig.TokenEnvVar's rule that fire-flow never
+// stores a credential's plaintext.
+type tokenConfigYAML struct {
+	EnvVar string `yaml:"env_var"`
+	Role   string `yaml:"role"`
+}
+
+// LoadTokens reads a token config file and resolves each entry's
+// EnvVar to its current value, so `fire-flow serve` never has a
+// plaintext token on disk: the config only says which environment
+// variable to read, and the operator sets that variable out of band
+// (a secrets manager, systemd's EnvironmentFile, ...). An entry whose
+// env var is unset or empty is skipped rather than erroring, since a
+// role an operator hasn't provisioned yet just isn't available.
+func LoadTokens(path string) ([]Token, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading token config %s: %w", path, err)
+	}
+	var cfg struct {
+		Tokens []tokenConfigYAML `yaml:"tokens"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing token config %s: %w", path, err)
+	}
+	var tokens []Token
+	for _, t := range cfg.Tokens {
+		role, ok := roleNames[t.Role]
+		if !ok {
+			return nil, fmt.Errorf("token config %s: unknown role %q (want readonly, trigger, or admin)", path, t.Role)
+		}
+		value := os.Getenv(t.EnvVar)
+		if value == "" {
+			continue
+		}
+		tokens = append(tokens, Token{Value: value, Role: role})
+	}
+	return tokens, nil
+}
+
+func (s *TokenStore) limiterFor(token string) *rate.Limiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.limiters[token]
+	if !ok {
+		l = rate.NewLimiter(s.limit, s.burst)
+		s.limiters[token] = l
+	}
+	return l
+}
+
+// Require returns middleware that rejects requests without a valid
+// bearer token of at least the given role, and 429s tokens over their
+// rate limit.
+func (s *TokenStore) Require(need Role, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		auth := req.Header.Get("Authorization")
+		const prefix = "Bearer "
+		if !strings.HasPrefix(auth, prefix) {
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+		token := strings.TrimPrefix(auth, prefix)
+		role, ok := s.tokens[token]
+		if !ok {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		if !role.Allows(need) {
+			http.Error(w, "token lacks required role", http.StatusForbidden)
+			return
+		}
+		if !s.limiterFor(token).AllowN(time.Now(), 1) {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,40 @@
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadTokensResolvesEnvVars(t *testing.T) {
+	t.Setenv("FIRE_FLOW_TEST_TRIGGER_TOKEN", "secret-value")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tokens.yaml")
+	yaml := "tokens:\n  - env_var: FIRE_FLOW_TEST_TRIGGER_TOKEN\n    role: trigger\n  - env_var: FIRE_FLOW_TEST_UNSET_TOKEN\n    role: admin\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatalf("writing token config: %v", err)
+	}
+
+	tokens, err := LoadTokens(path)
+	if err != nil {
+		t.Fatalf("LoadTokens: %v", err)
+	}
+	if len(tokens) != 1 {
+		t.Fatalf("tokens = %v, want exactly the one with a set env var", tokens)
+	}
+	if tokens[0].Value != "secret-value" || tokens[0].Role != RoleTrigger {
+		t.Fatalf("tokens[0] = %+v, want {secret-value RoleTrigger}", tokens[0])
+	}
+}
+
+func TestLoadTokensRejectsUnknownRole(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tokens.yaml")
+	if err := os.WriteFile(path, []byte("tokens:\n  - env_var: X\n    role: superuser\n"), 0o644); err != nil {
+		t.Fatalf("writing token config: %v", err)
+	}
+	if _, err := LoadTokens(path); err == nil {
+		t.Fatal("LoadTokens with an unknown role should fail")
+	}
+}
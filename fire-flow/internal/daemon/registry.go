@@ -0,0 +1,122 @@
+// Package daemon runs fire-flow as a long-lived process serving several
+// project roots at once, so one host can back multiple repos' TCR loops
+// without a process per repo.
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/lprior-repo/fire-flow/internal/state"
+)
+
+// Project is one managed project root's state and identity.
+type Project struct {
+	Name    string
+	RootDir string
+	Store   *state.Store
+}
+
+// Registry tracks every project root a daemon instance is serving,
+// keyed by name so CLI/API callers address a specific project instead of
+// relying on the daemon's current working directory.
+type Registry struct {
+	mu       sync.RWMutex
+	projects map[string]*Project
+}
+
+// NewRegistry returns an empty project registry.
+func NewRegistry() *Registry {
+	return &Registry{projects: make(map[string]*Project)}
+}
+
+// Add registers a project root under name. It errors if name is already
+// registered, since two projects sharing a name would make later lookups
+// ambiguous.
+func (r *Registry) Add(name, rootDir string, store *state.Store) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.projects[name]; exists {
+		return fmt.Errorf("project %q is already registered", name)
+	}
+	r.projects[name] = &Project{Name: name, RootDir: rootDir, Store: store}
+	return nil
+}
+
+// Get returns the registered project by name.
+func (r *Registry) Get(name string) (*Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.projects[name]
+	if !ok {
+		return nil, fmt.Errorf("no such project %q", name)
+	}
+	return p, nil
+}
+
+// List returns every registered project name.
+func (r *Registry) List() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.projects))
+	for name := range r.projects {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Remove unregisters a project root.
+func (r *Registry) Remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.projects, name)
+}
+
+// stateBackups mirrors internal/harness's convention for how many
+// rotated state.json backups a project keeps.
+const stateBackups = 3
+
+// projectConfigYAML is one entry of a registry config file: a project
+// name and the repo root fire-flow serves it out of.
+type projectConfigYAML struct {
+	Name    string `yaml:"name"`
+	RootDir string `yaml:"root_dir"`
+}
+
+// registryConfigYAML binds the registry config file `fire-flow serve`
+// loads at startup, listing every project root the daemon should serve.
+type registryConfigYAML struct {
+	Projects []projectConfigYAML `yaml:"projects"`
+}
+
+// LoadRegistry reads a registry config file and returns a Registry
+// populated with one Project per entry, each backed by its own
+// .opencode/tcr/state.json (the same layout internal/harness provisions
+// for a single-project checkout). A missing path is not an error: it
+// yields an empty registry, so `fire-flow serve` can start bare and have
+// projects added later via /admin/projects.
+func LoadRegistry(path string) (*Registry, error) {
+	r := NewRegistry()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading registry config %s: %w", path, err)
+	}
+	var cfg registryConfigYAML
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing registry config %s: %w", path, err)
+	}
+	for _, p := range cfg.Projects {
+		statePath := filepath.Join(p.RootDir, ".opencode", "tcr", "state.json")
+		if err := r.Add(p.Name, p.RootDir, state.NewStore(statePath, stateBackups)); err != nil {
+			return nil, fmt.Errorf("loading registry config %s: %w", path, err)
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,57 @@
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadRegistryMissingFileIsEmpty(t *testing.T) {
+	r, err := LoadRegistry(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	if err != nil {
+		t.Fatalf("LoadRegistry: %v", err)
+	}
+	if got := r.List(); len(got) != 0 {
+		t.Fatalf("List() = %v, want none", got)
+	}
+}
+
+func TestLoadRegistryPopulatesProjects(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "registry.yaml")
+	yaml := "projects:\n  - name: alpha\n    root_dir: /repos/alpha\n  - name: beta\n    root_dir: /repos/beta\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatalf("writing registry config: %v", err)
+	}
+
+	r, err := LoadRegistry(path)
+	if err != nil {
+		t.Fatalf("LoadRegistry: %v", err)
+	}
+	names := r.List()
+	if len(names) != 2 {
+		t.Fatalf("List() = %v, want 2 projects", names)
+	}
+	alpha, err := r.Get("alpha")
+	if err != nil {
+		t.Fatalf("Get(alpha): %v", err)
+	}
+	if alpha.RootDir != "/repos/alpha" {
+		t.Fatalf("alpha.RootDir = %q, want /repos/alpha", alpha.RootDir)
+	}
+	if alpha.Store.Path != filepath.Join("/repos/alpha", ".opencode", "tcr", "state.json") {
+		t.Fatalf("alpha.Store.Path = %q, want the project's .opencode/tcr/state.json", alpha.Store.Path)
+	}
+}
+
+func TestLoadRegistryRejectsDuplicateNames(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "registry.yaml")
+	yaml := "projects:\n  - name: alpha\n    root_dir: /repos/one\n  - name: alpha\n    root_dir: /repos/two\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatalf("writing registry config: %v", err)
+	}
+	if _, err := LoadRegistry(path); err == nil {
+		t.Fatal("LoadRegistry with a duplicate project name should fail")
+	}
+}
@@ -0,0 +1,54 @@
+package daemon
+
+import "sync"
+
+// Broadcaster fans out event lines to every live tail subscriber for a
+// given session, so `fire-flow tail` can attach without the daemon
+// needing to know about individual client connections up front.
+type Broadcaster struct {
+	mu   sync.Mutex
+	subs map[string]map[chan string]struct{}
+}
+
+// NewBroadcaster returns a Broadcaster with no subscribers.
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subs: map[string]map[chan string]struct{}{}}
+}
+
+// Subscribe registers a new listener for session, returning a channel
+// of lines and a cancel func the caller must call when done (typically
+// when its HTTP request's context is done) to stop leaking the channel.
+func (b *Broadcaster) Subscribe(session string) (ch chan string, cancel func()) {
+	ch = make(chan string, 64)
+	b.mu.Lock()
+	if b.subs[session] == nil {
+		b.subs[session] = map[chan string]struct{}{}
+	}
+	b.subs[session][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs[session], ch)
+		if len(b.subs[session]) == 0 {
+			delete(b.subs, session)
+		}
+		b.mu.Unlock()
+		close(ch)
+	}
+}
+
+// Publish sends line to every current subscriber of session. A
+// subscriber whose channel is full (a slow or stuck client) is skipped
+// rather than blocking the publisher, since a stuck tail client
+// shouldn't stall the cycle it's watching.
+func (b *Broadcaster) Publish(session, line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[session] {
+		select {
+		case ch <- line:
+		default:
+		}
+	}
+}
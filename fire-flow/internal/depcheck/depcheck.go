@@ -0,0 +1,107 @@
+// Package depcheck detects new Go module dependencies an AI attempt
+// added to go.mod, and checks them against an allow/deny policy (and
+// optionally the OSV vulnerability database) before a cycle is allowed
+// to commit.
+package depcheck
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Module is one entry from a go.mod require directive.
+type Module struct {
+	Path    string
+	Version string
+}
+
+var requireLine = regexp.MustCompile(`^\s*([^\s]+)\s+(v[0-9][^\s]*)`)
+
+// ParseRequires extracts every required module from a go.mod file's
+// contents, handling both the single-line `require x v1.2.3` form and
+// the `require (...)` block form. It deliberately doesn't depend on
+// golang.org/x/mod/modfile so this package stays a plain reader with no
+// build-list resolution semantics to keep in sync.
+func ParseRequires(goMod string) []Module {
+	var modules []Module
+	inBlock := false
+	for _, line := range strings.Split(goMod, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "require (":
+			inBlock = true
+			continue
+		case inBlock && trimmed == ")":
+			inBlock = false
+			continue
+		case inBlock:
+			if m := requireLine.FindStringSubmatch(trimmed); m != nil {
+				modules = append(modules, Module{Path: m[1], Version: m[2]})
+			}
+		case strings.HasPrefix(trimmed, "require "):
+			if m := requireLine.FindStringSubmatch(strings.TrimPrefix(trimmed, "require ")); m != nil {
+				modules = append(modules, Module{Path: m[1], Version: m[2]})
+			}
+		}
+	}
+	return modules
+}
+
+// AddedModules returns modules present in newGoMod but not oldGoMod,
+// keyed by import path (a version bump on an existing module is not an
+// addition; policy only governs new dependencies).
+func AddedModules(oldGoMod, newGoMod string) []Module {
+	before := map[string]bool{}
+	for _, m := range ParseRequires(oldGoMod) {
+		before[m.Path] = true
+	}
+	var added []Module
+	for _, m := range ParseRequires(newGoMod) {
+		if !before[m.Path] {
+			added = append(added, m)
+		}
+	}
+	return added
+}
+
+// Policy is an allow/deny list of module path prefixes. Deny is checked
+// first; when Allow is non-empty, anything not matching an Allow prefix
+// is also rejected (an allowlist mode), so a team can choose either
+// "block known-bad" or "only these vendors" enforcement.
+type Policy struct {
+	Allow []string
+	Deny  []string
+}
+
+// Violation explains why one added module failed policy.
+type Violation struct {
+	Module Module
+	Reason string
+}
+
+// Check evaluates modules against policy, returning one Violation per
+// disallowed module.
+func Check(modules []Module, policy Policy) []Violation {
+	var violations []Violation
+	for _, m := range modules {
+		if prefix, denied := matchAny(m.Path, policy.Deny); denied {
+			violations = append(violations, Violation{Module: m, Reason: "matches deny prefix " + prefix})
+			continue
+		}
+		if len(policy.Allow) > 0 {
+			if _, allowed := matchAny(m.Path, policy.Allow); !allowed {
+				violations = append(violations, Violation{Module: m, Reason: "not in allowlist"})
+			}
+		}
+	}
+	return violations
+}
+
+func matchAny(path string, prefixes []string) (string, bool) {
+	for _, p := range prefixes {
+		if strings.HasPrefix(path, p) {
+			return p, true
+		}
+	}
+	return "", false
+}
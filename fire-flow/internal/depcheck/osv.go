@@ -0,0 +1,69 @@
+package depcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// osvEndpoint is OSV's batch-free single-package query endpoint; see
+// https://osv.dev/docs/#tag/api/operation/OSV_QueryAffected.
+const osvEndpoint = "https://api.osv.dev/v1/query"
+
+// Vulnerability is the subset of an OSV response fire-flow surfaces.
+type Vulnerability struct {
+	ID      string `json:"id"`
+	Summary string `json:"summary"`
+}
+
+// QueryOSV asks OSV whether m has any known vulnerabilities, treating Go
+// modules as the "Go" ecosystem.
+func QueryOSV(client *http.Client, m Module) ([]Vulnerability, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	body, err := json.Marshal(map[string]any{
+		"version": m.Version,
+		"package": map[string]string{"name": m.Path, "ecosystem": "Go"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling OSV query for %s: %w", m.Path, err)
+	}
+	resp, err := client.Post(osvEndpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("querying OSV for %s: %w", m.Path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("OSV query for %s returned status %d", m.Path, resp.StatusCode)
+	}
+	var parsed struct {
+		Vulns []Vulnerability `json:"vulns"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("decoding OSV response for %s: %w", m.Path, err)
+	}
+	return parsed.Vulns, nil
+}
+
+// ScanAdded runs QueryOSV over every added module, returning a map of
+// module path to its vulnerabilities for any module that has at least
+// one. A query failure for one module doesn't abort the scan of the
+// rest; it's returned in errs keyed by module path so the caller can
+// decide whether an OSV outage should block the commit or just warn.
+func ScanAdded(client *http.Client, added []Module) (map[string][]Vulnerability, map[string]error) {
+	vulns := map[string][]Vulnerability{}
+	errs := map[string]error{}
+	for _, m := range added {
+		found, err := QueryOSV(client, m)
+		if err != nil {
+			errs[m.Path] = err
+			continue
+		}
+		if len(found) > 0 {
+			vulns[m.Path] = found
+		}
+	}
+	return vulns, errs
+}
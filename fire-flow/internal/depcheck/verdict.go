@@ -0,0 +1,70 @@
+package depcheck
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Verdict mirrors gate.Verdict's shape without importing internal/gate,
+// so depcheck stays usable from contexts (a plain CLI check, a test)
+// that don't want the rest of the gate package pulled in.
+type Verdict struct {
+	Allow   bool
+	Message string
+}
+
+// CheckGoModDiff is the single entry point the cycle engine or a gate
+// plugin wraps: given go.mod before/after an attempt and a policy, it
+// denies the commit if any added module violates policy, and optionally
+// (when scanVulns is true) if OSV reports a vulnerability for one.
+func CheckGoModDiff(oldGoMod, newGoMod string, policy Policy, scanVulns bool, client *http.Client) Verdict {
+	added := AddedModules(oldGoMod, newGoMod)
+	if len(added) == 0 {
+		return Verdict{Allow: true}
+	}
+
+	if violations := Check(added, policy); len(violations) > 0 {
+		return Verdict{Allow: false, Message: "dependency policy violation: " + summarizeViolations(violations)}
+	}
+
+	if !scanVulns {
+		return Verdict{Allow: true}
+	}
+	vulns, errs := ScanAdded(client, added)
+	if len(vulns) > 0 {
+		return Verdict{Allow: false, Message: "new dependency has known vulnerabilities: " + summarizeVulns(vulns)}
+	}
+	if len(errs) > 0 {
+		return Verdict{Allow: true, Message: "OSV scan incomplete for: " + summarizeErrs(errs)}
+	}
+	return Verdict{Allow: true}
+}
+
+func summarizeViolations(violations []Violation) string {
+	parts := make([]string, 0, len(violations))
+	for _, v := range violations {
+		parts = append(parts, fmt.Sprintf("%s (%s)", v.Module.Path, v.Reason))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func summarizeVulns(vulns map[string][]Vulnerability) string {
+	parts := make([]string, 0, len(vulns))
+	for path, found := range vulns {
+		ids := make([]string, 0, len(found))
+		for _, v := range found {
+			ids = append(ids, v.ID)
+		}
+		parts = append(parts, fmt.Sprintf("%s [%s]", path, strings.Join(ids, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func summarizeErrs(errs map[string]error) string {
+	parts := make([]string, 0, len(errs))
+	for path, err := range errs {
+		parts = append(parts, fmt.Sprintf("%s (%v)", path, err))
+	}
+	return strings.Join(parts, ", ")
+}
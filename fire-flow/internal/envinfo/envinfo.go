@@ -0,0 +1,101 @@
+// Package envinfo captures a snapshot of the workspace environment a
+// cycle ran in — tool versions, OS/kernel, and a few relevant env vars —
+// so a result that only reproduces on some runner machines can be traced
+// back to environment drift instead of guessed at.
+package envinfo
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Snapshot is the environment fire-flow ran a cycle in.
+type Snapshot struct {
+	OS     string `json:"os"`
+	Arch   string `json:"arch"`
+	Kernel string `json:"kernel,omitempty"` // `uname -r`; empty when unavailable (e.g. non-Unix)
+	// Tools maps a tool name (go, git, bd, opencode) to its version
+	// string, omitting any tool not found on PATH.
+	Tools map[string]string `json:"tools,omitempty"`
+	// Env maps each of the requested env var names to its value,
+	// omitting any that are unset.
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// tool is a version-probe command for one of the tools fire-flow shells
+// out to elsewhere, so a Capture can name it and get its version back the
+// same way that subprocess would see it.
+type tool struct {
+	name string
+	args []string
+}
+
+// DefaultTools are the tools fire-flow itself depends on: the Go
+// toolchain (internal/toolchain), git (internal/gitops), bd (internal/bead),
+// and the AI provider (internal/ai's default ExecRunner command).
+var DefaultTools = []string{"go", "git", "bd", "opencode"}
+
+var probes = map[string]tool{
+	"go":       {"go", []string{"version"}},
+	"git":      {"git", []string{"--version"}},
+	"bd":       {"bd", []string{"--version"}},
+	"opencode": {"opencode", []string{"--version"}},
+}
+
+// Capture builds a Snapshot for the current host: OS/arch/kernel, the
+// version of each name in tools (skipping ones not on PATH), and the
+// value of each name in envVars (skipping ones that are unset).
+func Capture(ctx context.Context, tools []string, envVars []string) Snapshot {
+	snap := Snapshot{
+		OS:     runtime.GOOS,
+		Arch:   runtime.GOARCH,
+		Kernel: kernelVersion(ctx),
+	}
+	for _, name := range tools {
+		p, ok := probes[name]
+		if !ok {
+			continue
+		}
+		out, err := exec.CommandContext(ctx, p.name, p.args...).Output()
+		if err != nil {
+			continue
+		}
+		snap.setTool(name, strings.TrimSpace(string(out)))
+	}
+	for _, name := range envVars {
+		if v, ok := os.LookupEnv(name); ok {
+			snap.setEnv(name, v)
+		}
+	}
+	return snap
+}
+
+func (s *Snapshot) setTool(name, version string) {
+	if s.Tools == nil {
+		s.Tools = make(map[string]string)
+	}
+	s.Tools[name] = version
+}
+
+func (s *Snapshot) setEnv(name, value string) {
+	if s.Env == nil {
+		s.Env = make(map[string]string)
+	}
+	s.Env[name] = value
+}
+
+// kernelVersion runs `uname -r`, returning "" on platforms without it
+// (Windows) or if it fails for any reason.
+func kernelVersion(ctx context.Context) string {
+	if runtime.GOOS == "windows" {
+		return ""
+	}
+	out, err := exec.CommandContext(ctx, "uname", "-r").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,173 @@
+// Package epic batches a set of related beads (those sharing an
+// EpicID) into one ordered run: dependencies between beads are resolved
+// into levels, each level runs to completion (optionally across
+// multiple workers) before the next starts, and a consolidated report
+// records every bead's outcome.
+package epic
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+	"github.com/lprior-repo/fire-flow/internal/budget"
+)
+
+// RunFunc runs one bead to completion (whatever "running a bead" means
+// to the caller, e.g. dispatching a full TCR cycle) and reports whether
+// it succeeded.
+type RunFunc func(bead.Issue) error
+
+// Outcome is one bead's result within an epic run.
+type Outcome struct {
+	BeadID   string
+	Err      error
+	Duration time.Duration
+}
+
+// Report consolidates every bead outcome from one epic run.
+type Report struct {
+	EpicID     string
+	Outcomes   []Outcome
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// FailureCount reports how many beads in the report failed.
+func (r Report) FailureCount() int {
+	n := 0
+	for _, o := range r.Outcomes {
+		if o.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Options configures an epic run.
+type Options struct {
+	// Workers bounds how many beads within the same dependency level run
+	// concurrently. 0 or 1 means sequential.
+	Workers int
+	// MaxFailures stops the run once more than this many beads have
+	// failed, leaving beads not yet started untouched. 0 means no
+	// threshold: the run always processes every level.
+	MaxFailures int
+}
+
+// Resolve returns every issue belonging to epicID, in the order they
+// appear in issues.
+func Resolve(issues []bead.Issue, epicID string) []bead.Issue {
+	var out []bead.Issue
+	for _, i := range issues {
+		if i.EpicID == epicID {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// Order groups issues into dependency levels: every issue in level N
+// depends only on issues in levels before it (or on beads outside the
+// set entirely, assumed already satisfied). Levels run one after
+// another; beads within the same level have no ordering constraint
+// between them.
+func Order(issues []bead.Issue) ([][]bead.Issue, error) {
+	inSet := make(map[string]bool, len(issues))
+	for _, i := range issues {
+		inSet[i.ID] = true
+	}
+	remaining := make(map[string]bead.Issue, len(issues))
+	for _, i := range issues {
+		remaining[i.ID] = i
+	}
+	done := map[string]bool{}
+
+	var levels [][]bead.Issue
+	for len(remaining) > 0 {
+		var level []bead.Issue
+		for _, issue := range remaining {
+			if isReady(issue, inSet, done) {
+				level = append(level, issue)
+			}
+		}
+		if len(level) == 0 {
+			return nil, fmt.Errorf("epic: dependency cycle among beads %v", remainingIDs(remaining))
+		}
+		for _, issue := range level {
+			delete(remaining, issue.ID)
+			done[issue.ID] = true
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+func isReady(issue bead.Issue, inSet map[string]bool, done map[string]bool) bool {
+	for _, dep := range issue.DependsOn {
+		if inSet[dep] && !done[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+func remainingIDs(m map[string]bead.Issue) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Run resolves epicID's beads, orders them by dependency, and runs each
+// through run one level at a time, up to opts.Workers concurrently
+// within a level. It stops after the level in which the failure
+// threshold was first exceeded; beads in later levels are left
+// untouched and absent from the report.
+func Run(issues []bead.Issue, epicID string, run RunFunc, opts Options) (Report, error) {
+	scoped := Resolve(issues, epicID)
+	levels, err := Order(scoped)
+	if err != nil {
+		return Report{}, err
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	pool := budget.NewPool(workers)
+
+	report := Report{EpicID: epicID, StartedAt: time.Now()}
+	for _, level := range levels {
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for _, issue := range level {
+			wg.Add(1)
+			go func(issue bead.Issue) {
+				defer wg.Done()
+				release, err := pool.Acquire(context.Background())
+				if err != nil {
+					mu.Lock()
+					report.Outcomes = append(report.Outcomes, Outcome{BeadID: issue.ID, Err: err})
+					mu.Unlock()
+					return
+				}
+				defer release()
+
+				start := time.Now()
+				runErr := run(issue)
+				mu.Lock()
+				report.Outcomes = append(report.Outcomes, Outcome{BeadID: issue.ID, Err: runErr, Duration: time.Since(start)})
+				mu.Unlock()
+			}(issue)
+		}
+		wg.Wait()
+		if opts.MaxFailures > 0 && report.FailureCount() > opts.MaxFailures {
+			break
+		}
+	}
+	report.FinishedAt = time.Now()
+	return report, nil
+}
@@ -0,0 +1,40 @@
+// Package execbackend abstracts where fire-flow runs a bead's AI and test
+// commands: directly on the host, inside a container for dependency
+// isolation, or on a remote host over SSH.
+package execbackend
+
+import "context"
+
+// Backend runs a command against a working directory and returns its
+// combined output.
+type Backend interface {
+	Run(ctx context.Context, dir string, command string, args []string) (output string, err error)
+}
+
+// Config selects and configures a Backend. Image/Tool are only used by
+// the container backend; Host/RemoteDir/IdentityFile only by the remote
+// backend.
+type Config struct {
+	Kind         string // "local", "container", or "remote"
+	Image        string
+	Tool         string // "docker" or "podman"
+	Host         string // user@host, as passed to ssh/rsync
+	RemoteDir    string // working directory on the remote host
+	IdentityFile string // optional -i for ssh/rsync, empty to use ssh defaults
+}
+
+// New builds the Backend described by cfg.
+func New(cfg Config) Backend {
+	switch cfg.Kind {
+	case "container":
+		tool := cfg.Tool
+		if tool == "" {
+			tool = "docker"
+		}
+		return ContainerBackend{Image: cfg.Image, Tool: tool}
+	case "remote":
+		return RemoteBackend{Host: cfg.Host, RemoteDir: cfg.RemoteDir, IdentityFile: cfg.IdentityFile}
+	default:
+		return LocalBackend{}
+	}
+}
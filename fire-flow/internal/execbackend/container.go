@@ -0,0 +1,35 @@
+package execbackend
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// ContainerBackend runs commands inside a container, bind-mounting the
+// overlay's merged directory so the container sees the exact files the
+// AI/test run should operate on.
+type ContainerBackend struct {
+	Image string
+	Tool  string // "docker" or "podman"
+}
+
+func (b ContainerBackend) Run(ctx context.Context, dir string, command string, args []string) (string, error) {
+	if b.Image == "" {
+		return "", fmt.Errorf("container backend: no image configured")
+	}
+	dockerArgs := append([]string{
+		"run", "--rm",
+		"-v", dir + ":/workspace",
+		"-w", "/workspace",
+		b.Image,
+		command,
+	}, args...)
+
+	cmd := exec.CommandContext(ctx, b.Tool, dockerArgs...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("running %s in %s via %s: %w", command, b.Image, b.Tool, err)
+	}
+	return string(out), nil
+}
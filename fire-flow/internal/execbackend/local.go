@@ -0,0 +1,20 @@
+package execbackend
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// LocalBackend runs commands directly on the host.
+type LocalBackend struct{}
+
+func (LocalBackend) Run(ctx context.Context, dir string, command string, args []string) (string, error) {
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("running %s: %w", command, err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,98 @@
+package execbackend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// NixEnv wraps a command with `nix develop -c` or `devenv shell -- `, so
+// the toolchain inside the overlay matches CI exactly. Shell evaluation
+// is expensive, so the resolved environment (as reported by `nix
+// print-dev-env` / `devenv print-dev-env`) is cached on disk, keyed by a
+// hash of the flake/devenv files, and skipped when the cache is fresh.
+type NixEnv struct {
+	Kind       string // "flake" or "devenv"
+	ProjectDir string
+	CacheDir   string
+}
+
+// Wrap prepends the activation command for env's kind to command/args.
+func (env NixEnv) Wrap(command string, args []string) (string, []string) {
+	switch env.Kind {
+	case "devenv":
+		return "devenv", append([]string{"shell", "--", command}, args...)
+	default:
+		return "nix", append([]string{"develop", env.ProjectDir, "-c", command}, args...)
+	}
+}
+
+// EnsureCached evaluates and caches the shell environment if the flake or
+// devenv files have changed since the last cache write, returning the
+// cache file's path.
+func (env NixEnv) EnsureCached(ctx context.Context) (string, error) {
+	specFiles := env.specFiles()
+	hash, err := hashFiles(specFiles)
+	if err != nil {
+		return "", fmt.Errorf("hashing nix/devenv spec files: %w", err)
+	}
+	cachePath := filepath.Join(env.CacheDir, hash+".json")
+	if _, err := os.Stat(cachePath); err == nil {
+		return cachePath, nil
+	}
+
+	evalCmd, evalArgs := env.evalCommand()
+	cmd := exec.CommandContext(ctx, evalCmd, evalArgs...)
+	cmd.Dir = env.ProjectDir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("evaluating %s environment: %w", env.Kind, err)
+	}
+	if err := os.MkdirAll(env.CacheDir, 0o755); err != nil {
+		return "", fmt.Errorf("creating nix env cache dir: %w", err)
+	}
+	if err := os.WriteFile(cachePath, out, 0o644); err != nil {
+		return "", fmt.Errorf("writing nix env cache %s: %w", cachePath, err)
+	}
+	return cachePath, nil
+}
+
+func (env NixEnv) evalCommand() (string, []string) {
+	if env.Kind == "devenv" {
+		return "devenv", []string{"print-dev-env"}
+	}
+	return "nix", []string{"print-dev-env", env.ProjectDir}
+}
+
+func (env NixEnv) specFiles() []string {
+	if env.Kind == "devenv" {
+		return []string{filepath.Join(env.ProjectDir, "devenv.nix"), filepath.Join(env.ProjectDir, "devenv.lock")}
+	}
+	return []string{filepath.Join(env.ProjectDir, "flake.nix"), filepath.Join(env.ProjectDir, "flake.lock")}
+}
+
+func hashFiles(paths []string) (string, error) {
+	h := sha256.New()
+	enc := json.NewEncoder(h)
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if err := enc.Encode(struct {
+			Path string
+			Data []byte
+		}{p, data}); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,75 @@
+package execbackend
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// RemoteBackend runs commands on a designated Linux host over SSH,
+// syncing the overlay's merged directory there and back with rsync. It
+// lets macOS/Windows users drive the Linux-only overlay workflow
+// transparently: mount/test/AI steps all happen on the remote box.
+type RemoteBackend struct {
+	Host         string // user@host, as passed to ssh/rsync
+	RemoteDir    string // working directory on the remote host
+	IdentityFile string // optional -i for ssh/rsync, empty to use ssh defaults
+}
+
+// Sync pushes a local directory to the remote host before a run and pulls
+// it back afterward; Run alone does not sync.
+func (b RemoteBackend) Sync(ctx context.Context, localDir string, toRemote bool) error {
+	src, dst := localDir+"/", b.Host+":"+b.RemoteDir+"/"
+	if !toRemote {
+		src, dst = dst, src
+	}
+	args := b.rsyncArgs(src, dst)
+	cmd := exec.CommandContext(ctx, "rsync", args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("rsync %s -> %s: %w: %s", src, dst, err, out)
+	}
+	return nil
+}
+
+func (b RemoteBackend) Run(ctx context.Context, dir string, command string, args []string) (string, error) {
+	sshArgs := b.sshArgs()
+	remoteCmd := fmt.Sprintf("cd %s && %s", shellQuote(b.RemoteDir), shellQuoteAll(append([]string{command}, args...)))
+	sshArgs = append(sshArgs, b.Host, remoteCmd)
+
+	cmd := exec.CommandContext(ctx, "ssh", sshArgs...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("running %s on %s: %w", command, b.Host, err)
+	}
+	return string(out), nil
+}
+
+func (b RemoteBackend) sshArgs() []string {
+	if b.IdentityFile == "" {
+		return nil
+	}
+	return []string{"-i", b.IdentityFile}
+}
+
+func (b RemoteBackend) rsyncArgs(src, dst string) []string {
+	args := []string{"-az", "--delete"}
+	if b.IdentityFile != "" {
+		args = append(args, "-e", "ssh -i "+b.IdentityFile)
+	}
+	return append(args, src, dst)
+}
+
+func shellQuote(s string) string {
+	return "'" + s + "'"
+}
+
+func shellQuoteAll(parts []string) string {
+	quoted := ""
+	for i, p := range parts {
+		if i > 0 {
+			quoted += " "
+		}
+		quoted += shellQuote(p)
+	}
+	return quoted
+}
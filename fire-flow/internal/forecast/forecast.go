@@ -0,0 +1,100 @@
+// Package forecast estimates how long the remaining bead backlog will
+// take, from the actual cycle counts and wall-clock time fire-flow spent
+// on beads it already completed.
+package forecast
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+)
+
+// Throughput summarizes completed-bead history into the two numbers a
+// forecast needs: how many cycles a bead typically takes, and how long
+// that takes in wall-clock time.
+type Throughput struct {
+	BeadsCompleted   int
+	AvgCyclesPerBead float64
+	AvgDuration      time.Duration
+}
+
+// ComputeThroughput groups history entries by BeadID and averages across
+// beads that reached a terminal committed state (DecisionCommitted or
+// DecisionDocsOnly); reverted and gate-blocked cycles count toward a
+// bead's cycle count but aren't themselves completions.
+func ComputeThroughput(entries []cycle.Summary) (Throughput, error) {
+	type beadStats struct {
+		cycles   int
+		duration time.Duration
+		done     bool
+	}
+	byBead := map[string]*beadStats{}
+	for _, e := range entries {
+		st, ok := byBead[e.BeadID]
+		if !ok {
+			st = &beadStats{}
+			byBead[e.BeadID] = st
+		}
+		st.cycles++
+		d, err := time.ParseDuration(e.Duration)
+		if err != nil {
+			return Throughput{}, fmt.Errorf("parsing duration %q for bead %s: %w", e.Duration, e.BeadID, err)
+		}
+		st.duration += d
+		if e.Decision == cycle.DecisionCommitted || e.Decision == cycle.DecisionDocsOnly {
+			st.done = true
+		}
+	}
+
+	var completed int
+	var totalCycles int
+	var totalDuration time.Duration
+	for _, st := range byBead {
+		if !st.done {
+			continue
+		}
+		completed++
+		totalCycles += st.cycles
+		totalDuration += st.duration
+	}
+	if completed == 0 {
+		return Throughput{}, nil
+	}
+	return Throughput{
+		BeadsCompleted:   completed,
+		AvgCyclesPerBead: float64(totalCycles) / float64(completed),
+		AvgDuration:      totalDuration / time.Duration(completed),
+	}, nil
+}
+
+// Forecast is the estimated completion for the remaining backlog.
+type Forecast struct {
+	RemainingBeads      int           `json:"remaining_beads"`
+	Workers             int           `json:"workers"`
+	AvgCyclesPerBead    float64       `json:"avg_cycles_per_bead"`
+	AvgDurationPerBead  time.Duration `json:"avg_duration_per_bead"`
+	EstimatedDuration   time.Duration `json:"estimated_duration"`
+	EstimatedCompletion time.Time     `json:"estimated_completion"`
+}
+
+// Complete projects when remainingBeads will finish, given throughput
+// observed so far and workers processing the backlog in parallel. A
+// throughput with no completed beads yet can't forecast anything
+// meaningful, so callers should check BeadsCompleted before trusting the
+// result.
+func Complete(throughput Throughput, remainingBeads, workers int, now time.Time) Forecast {
+	if workers < 1 {
+		workers = 1
+	}
+	sequential := time.Duration(float64(remainingBeads) * float64(throughput.AvgDuration))
+	parallelized := sequential / time.Duration(workers)
+	return Forecast{
+		RemainingBeads:      remainingBeads,
+		Workers:             workers,
+		AvgCyclesPerBead:    throughput.AvgCyclesPerBead,
+		AvgDurationPerBead:  throughput.AvgDuration,
+		EstimatedDuration:   parallelized,
+		EstimatedCompletion: now.Add(parallelized),
+	}
+}
@@ -0,0 +1,40 @@
+package gate
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// DefaultDocPatterns are the file globs (matched against the base name)
+// that DocsOnly treats as documentation by default.
+var DefaultDocPatterns = []string{"*.md", "*.mdx", "*.txt", "*.rst"}
+
+// DocsOnly reports whether every changed file matches one of patterns,
+// so a cycle touching only documentation can skip the RED requirement
+// and the test run entirely. An empty changed list is not doc-only —
+// there's nothing to fast-path.
+func DocsOnly(changed []string, patterns []string) bool {
+	if len(changed) == 0 {
+		return false
+	}
+	if len(patterns) == 0 {
+		patterns = DefaultDocPatterns
+	}
+	for _, f := range changed {
+		if !matchesAnyPattern(f, patterns) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesAnyPattern(path string, patterns []string) bool {
+	base := filepath.Base(path)
+	lower := strings.ToLower(base)
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(strings.ToLower(p), lower); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Package gate implements the TDD gate: the checks that run before a
+// cycle is allowed to commit, beyond the base GREEN-blocks/RED-allows
+// rule described in FIRE-FLOW_PLAN.md.
+package gate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// Context is the JSON payload sent to an external gate check on stdin.
+// It carries just enough for the check to make a decision without
+// shelling back out to git or bd itself.
+type Context struct {
+	BeadID       string   `json:"bead_id"`
+	State        string   `json:"state"` // "RED" or "GREEN"
+	ChangedFiles []string `json:"changed_files"`
+	RepoDir      string   `json:"repo_dir"`
+}
+
+// Verdict is what an external gate check prints to stdout as JSON.
+type Verdict struct {
+	Allow   bool   `json:"allow"`
+	Message string `json:"message"`
+	// DocsOnly is set by Decide, never by a plugin or rule, when the
+	// verdict came from the doc-only fast path rather than a normal
+	// test-backed evaluation.
+	DocsOnly bool `json:"docs_only,omitempty"`
+}
+
+// PluginCheck runs an external executable as a gate check: it receives
+// ctx marshaled as JSON on stdin, and must print a Verdict as JSON on
+// stdout within Timeout. This lets teams add organization-specific
+// rules (license headers, migration checks) without forking fire-flow.
+type PluginCheck struct {
+	Name    string        `yaml:"name"`
+	Path    string        `yaml:"path"`
+	Args    []string      `yaml:"args"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// defaultTimeout applies when a PluginCheck doesn't set one, so a
+// hanging check can't stall a cycle indefinitely.
+const defaultTimeout = 10 * time.Second
+
+// Run executes the plugin and parses its verdict. A nonzero exit code
+// without a parseable verdict is treated as a deny, since a plugin that
+// crashed rather than reasoned about the change shouldn't wave it through.
+func (p PluginCheck) Run(ctx context.Context, gctx Context) (Verdict, error) {
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	payload, err := json.Marshal(gctx)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("marshaling gate context for %s: %w", p.Name, err)
+	}
+
+	cmd := exec.CommandContext(runCtx, p.Path, p.Args...)
+	cmd.Stdin = bytes.NewReader(payload)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	var verdict Verdict
+	if err := json.Unmarshal(bytes.TrimSpace(stdout.Bytes()), &verdict); err != nil {
+		if runErr != nil {
+			return Verdict{Allow: false, Message: fmt.Sprintf("gate check %s failed: %v: %s", p.Name, runErr, stderr.String())}, nil
+		}
+		return Verdict{}, fmt.Errorf("parsing verdict from gate check %s: %w (stdout: %s)", p.Name, err, stdout.String())
+	}
+	return verdict, nil
+}
+
+// RunAll runs checks in order, stopping at the first deny (order matters:
+// cheaper or more decisive checks should run first). It returns the
+// first denying verdict, or an allowing verdict if every check allows.
+func RunAll(ctx context.Context, checks []PluginCheck, gctx Context) (Verdict, error) {
+	for _, check := range checks {
+		verdict, err := check.Run(ctx, gctx)
+		if err != nil {
+			return Verdict{}, fmt.Errorf("running gate check %s: %w", check.Name, err)
+		}
+		if !verdict.Allow {
+			return verdict, nil
+		}
+	}
+	return Verdict{Allow: true}, nil
+}
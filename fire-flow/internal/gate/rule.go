@@ -0,0 +1,114 @@
+package gate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+)
+
+// Rule is one entry of a declarative rules file: when its condition
+// matches the gate Context, it produces Verdict instead of falling
+// through to the base GREEN-blocks/RED-allows behavior. Rules are
+// evaluated in order; the first match wins.
+//
+// A rule file is a list of these, e.g.:
+//
+//	rules:
+//	  - when: { changed: "*_test.go" }
+//	    allow: true
+//	    message: "test file edits always allowed"
+//	  - when: { state: GREEN, changed: "*.go" }
+//	    allow: false
+//	    message: "no source changes while GREEN"
+type Rule struct {
+	When    Condition `yaml:"when"`
+	Allow   bool      `yaml:"allow"`
+	Message string    `yaml:"message"`
+}
+
+// Condition matches a gate Context. Every non-empty field must match
+// for the condition to hold; an empty field is ignored.
+type Condition struct {
+	// State, if set, must equal ctx.State ("RED" or "GREEN").
+	State string `yaml:"state"`
+	// Changed, if set, is a glob (as in path/filepath.Match) that at
+	// least one of ctx.ChangedFiles must match.
+	Changed string `yaml:"changed"`
+	// PathPrefix, if set, must prefix at least one of ctx.ChangedFiles;
+	// used for protected-path rules where a glob is awkward.
+	PathPrefix string `yaml:"path_prefix"`
+}
+
+// Matches reports whether every set field of c holds for ctx.
+func (c Condition) Matches(ctx Context) bool {
+	if c.State != "" && c.State != ctx.State {
+		return false
+	}
+	if c.Changed != "" && !anyMatch(c.Changed, ctx.ChangedFiles) {
+		return false
+	}
+	if c.PathPrefix != "" && !anyPrefixed(c.PathPrefix, ctx.ChangedFiles) {
+		return false
+	}
+	return true
+}
+
+func anyMatch(pattern string, files []string) bool {
+	for _, f := range files {
+		if ok, _ := filepath.Match(pattern, filepath.Base(f)); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, f); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func anyPrefixed(prefix string, files []string) bool {
+	for _, f := range files {
+		if len(f) >= len(prefix) && f[:len(prefix)] == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// EvaluateRules returns the verdict of the first matching rule. When no
+// rule matches, ok is false and the caller should fall back to the base
+// GREEN-blocks/RED-allows rule.
+func EvaluateRules(rules []Rule, ctx Context) (verdict Verdict, ok bool) {
+	for _, rule := range rules {
+		if rule.When.Matches(ctx) {
+			return Verdict{Allow: rule.Allow, Message: rule.Message}, true
+		}
+	}
+	return Verdict{}, false
+}
+
+// Decide applies the doc-only fast path first (a change touching only
+// docPatterns needs neither RED nor a test run), then the rule DSL,
+// falling back to the base rule (deny commits while GREEN, allow
+// otherwise) when nothing matches, and finally to any configured exec
+// plugin checks when the base rule would allow. This gives one
+// evaluation order that covers test-file exceptions, doc-only changes,
+// and protected paths uniformly.
+func Decide(runCtx context.Context, rules []Rule, plugins []PluginCheck, docPatterns []string, ctx Context) (Verdict, error) {
+	if DocsOnly(ctx.ChangedFiles, docPatterns) {
+		return Verdict{Allow: true, DocsOnly: true, Message: "docs-only change: fast path"}, nil
+	}
+	if verdict, ok := EvaluateRules(rules, ctx); ok {
+		return verdict, nil
+	}
+	if ctx.State == "GREEN" {
+		return Verdict{Allow: false, Message: "gate: no source changes allowed while GREEN"}, nil
+	}
+	if len(plugins) == 0 {
+		return Verdict{Allow: true}, nil
+	}
+	verdict, err := RunAll(runCtx, plugins, ctx)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("evaluating plugin checks: %w", err)
+	}
+	return verdict, nil
+}
@@ -0,0 +1,65 @@
+package gitops
+
+import (
+	"fmt"
+	"os"
+)
+
+// AuthConfig describes how to authenticate against a remote, since
+// PushBead can't assume ambient credentials are configured on every
+// worker host.
+type AuthConfig struct {
+	// SSHKeyPath, if set, points at a private key used for git+ssh
+	// remotes via GIT_SSH_COMMAND.
+	SSHKeyPath string
+	// TokenEnvVar, if set, names an environment variable holding an
+	// HTTPS token; fire-flow only checks that it is non-empty and never
+	// logs or passes on the token value itself, only its env var name,
+	// through to git's credential helper.
+	TokenEnvVar string
+}
+
+// Env returns the environment variables PushBead should set on the git
+// subprocess to apply cfg, or an error if a configured credential source
+// is missing.
+func (cfg AuthConfig) Env() ([]string, error) {
+	var env []string
+	if cfg.SSHKeyPath != "" {
+		if _, err := os.Stat(cfg.SSHKeyPath); err != nil {
+			return nil, fmt.Errorf("configured SSH key %s: %w", cfg.SSHKeyPath, err)
+		}
+		env = append(env, "GIT_SSH_COMMAND=ssh -i "+cfg.SSHKeyPath+" -o IdentitiesOnly=yes")
+	}
+	if cfg.TokenEnvVar != "" {
+		if os.Getenv(cfg.TokenEnvVar) == "" {
+			return nil, fmt.Errorf("token env var %s is set as the credential source but is empty", cfg.TokenEnvVar)
+		}
+		self, err := os.Executable()
+		if err != nil {
+			return nil, fmt.Errorf("resolving fire-flow binary path for GIT_ASKPASS: %w", err)
+		}
+		// The token itself is supplied to git via this same binary's
+		// `askpass` subcommand, which reads TokenEnvVar at run time, so
+		// the token never appears in argv, logs, or this process's
+		// environment dump. git runs GIT_ASKPASS through a shell, so a
+		// path plus a subcommand argument works the same as a
+		// standalone credential-helper executable would.
+		env = append(env, "GIT_ASKPASS="+self+" askpass")
+		env = append(env, "FIRE_FLOW_TOKEN_ENV="+cfg.TokenEnvVar)
+	}
+	return env, nil
+}
+
+// AuthError wraps a push failure that looks like an authentication
+// problem, so callers can surface a clear message instead of a generic
+// "pushed:false".
+type AuthError struct {
+	Remote string
+	Err    error
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("authenticating to %s: %v (check AuthConfig SSH key / token env var)", e.Remote, e.Err)
+}
+
+func (e *AuthError) Unwrap() error { return e.Err }
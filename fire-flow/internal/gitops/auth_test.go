@@ -0,0 +1,49 @@
+package gitops
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAuthConfigEnvWithTokenEnvVar(t *testing.T) {
+	t.Setenv("FIRE_FLOW_TEST_TOKEN", "secret-token")
+	cfg := AuthConfig{TokenEnvVar: "FIRE_FLOW_TEST_TOKEN"}
+
+	env, err := cfg.Env()
+	if err != nil {
+		t.Fatalf("Env: %v", err)
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatalf("os.Executable: %v", err)
+	}
+
+	var gotAskpass, gotTokenEnv bool
+	for _, kv := range env {
+		if kv == "GIT_ASKPASS="+self+" askpass" {
+			gotAskpass = true
+		}
+		if kv == "FIRE_FLOW_TOKEN_ENV=FIRE_FLOW_TEST_TOKEN" {
+			gotTokenEnv = true
+		}
+		if strings.Contains(kv, "secret-token") {
+			t.Fatalf("Env leaked the token value into an env entry: %q", kv)
+		}
+	}
+	if !gotAskpass {
+		t.Errorf("Env() = %v, want a GIT_ASKPASS entry pointing at this binary's askpass subcommand", env)
+	}
+	if !gotTokenEnv {
+		t.Errorf("Env() = %v, want FIRE_FLOW_TOKEN_ENV set to the configured env var name", env)
+	}
+}
+
+func TestAuthConfigEnvRejectsEmptyTokenEnvVar(t *testing.T) {
+	t.Setenv("FIRE_FLOW_TEST_EMPTY_TOKEN", "")
+	cfg := AuthConfig{TokenEnvVar: "FIRE_FLOW_TEST_EMPTY_TOKEN"}
+	if _, err := cfg.Env(); err == nil {
+		t.Fatal("Env with an empty token env var should fail")
+	}
+}
@@ -0,0 +1,29 @@
+package gitops
+
+import (
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/config"
+)
+
+// CommitAs stages all changes in repoDir and commits them under author's
+// git identity with a Fire-Flow-Bead trailer for beadID, so commits from
+// different engineers' beads on a shared runner host are attributed
+// correctly instead of all appearing under whatever account the
+// orchestrator runs as.
+func CommitAs(repoDir string, author config.Author, subject, beadID string) error {
+	env := []string{
+		"GIT_AUTHOR_NAME=" + author.Name,
+		"GIT_AUTHOR_EMAIL=" + author.Email,
+		"GIT_COMMITTER_NAME=" + author.Name,
+		"GIT_COMMITTER_EMAIL=" + author.Email,
+	}
+	if err := runEnv(repoDir, nil, "add", "-A"); err != nil {
+		return fmt.Errorf("staging changes for bead %s: %w", beadID, err)
+	}
+	message := CommitMessage(subject, beadID)
+	if err := runEnv(repoDir, env, "commit", "-m", message); err != nil {
+		return fmt.Errorf("committing bead %s as %s: %w", beadID, author.Email, err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package gitops
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// repoLocks serializes git operations per repository so parallel workers
+// don't race on .git/index.lock. Keyed by repoDir; entries are created
+// lazily and never removed, which is fine since fire-flow only ever
+// touches a small, fixed set of repos per process.
+var (
+	repoLocksMu sync.Mutex
+	repoLocks   = map[string]*sync.Mutex{}
+)
+
+func lockFor(repoDir string) *sync.Mutex {
+	repoLocksMu.Lock()
+	defer repoLocksMu.Unlock()
+	l, ok := repoLocks[repoDir]
+	if !ok {
+		l = &sync.Mutex{}
+		repoLocks[repoDir] = l
+	}
+	return l
+}
+
+// Serialize runs fn while holding repoDir's lock, retrying up to
+// maxAttempts times if fn fails with a transient "index.lock exists"
+// error left behind by a git process that was killed mid-operation.
+func Serialize(repoDir string, maxAttempts int, fn func() error) error {
+	lock := lockFor(repoDir)
+	lock.Lock()
+	defer lock.Unlock()
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+		if !isLockContention(lastErr) {
+			return lastErr
+		}
+		time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+	}
+	return fmt.Errorf("giving up after %d attempts, repo still locked: %w", maxAttempts, lastErr)
+}
+
+func isLockContention(err error) bool {
+	return strings.Contains(err.Error(), "index.lock") ||
+		strings.Contains(err.Error(), "Another git process seems to be running")
+}
@@ -0,0 +1,78 @@
+package gitops
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// MergeQueueResult reports the outcome of enqueuing a branch into a
+// GitHub merge queue / GitLab merge train, once it lands.
+type MergeQueueResult struct {
+	Branch    string
+	MergedSHA string
+}
+
+// PushToMergeQueue pushes branch and enqueues it via `gh pr merge --auto
+// --merge-queue`, then polls until the PR is merged or the poll budget is
+// exhausted. Callers use MergedSHA to update the bead's completion record.
+func PushToMergeQueue(repoDir, branch string, pollInterval time.Duration, pollAttempts int) (MergeQueueResult, error) {
+	result := MergeQueueResult{Branch: branch}
+
+	if err := run(repoDir, "push", "-u", "origin", branch); err != nil {
+		return result, fmt.Errorf("pushing %s: %w", branch, err)
+	}
+	if err := enqueue(repoDir, branch); err != nil {
+		return result, fmt.Errorf("enqueuing %s: %w", branch, err)
+	}
+
+	for i := 0; i < pollAttempts; i++ {
+		sha, merged, err := mergeStatus(repoDir, branch)
+		if err != nil {
+			return result, fmt.Errorf("polling merge status for %s: %w", branch, err)
+		}
+		if merged {
+			result.MergedSHA = sha
+			return result, nil
+		}
+		time.Sleep(pollInterval)
+	}
+	return result, fmt.Errorf("branch %s did not merge within %d polls", branch, pollAttempts)
+}
+
+func enqueue(repoDir, branch string) error {
+	cmd := exec.Command("gh", "pr", "merge", branch, "--auto", "--merge-queue")
+	cmd.Dir = repoDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// mergeStatus reports the merge commit SHA and whether the PR for branch
+// has been merged yet.
+func mergeStatus(repoDir, branch string) (sha string, merged bool, err error) {
+	cmd := exec.Command("gh", "pr", "view", branch, "--json", "state,mergeCommit")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", false, err
+	}
+	state := string(out)
+	if !strings.Contains(state, `"MERGED"`) {
+		return "", false, nil
+	}
+	// mergeCommit.oid is the only field we need; a full JSON struct is
+	// overkill for a single field extracted right after a state check.
+	idx := strings.Index(state, `"oid":"`)
+	if idx == -1 {
+		return "", true, nil
+	}
+	rest := state[idx+len(`"oid":"`):]
+	end := strings.Index(rest, `"`)
+	if end == -1 {
+		return "", true, nil
+	}
+	return rest[:end], true, nil
+}
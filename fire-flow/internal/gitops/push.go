@@ -0,0 +1,123 @@
+// Package gitops wraps the git (and gh) invocations fire-flow needs to
+// land a bead's changes: committing, pushing, and falling back to a PR
+// when a fast-forward isn't possible.
+package gitops
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// PushResult reports how a bead's changes were landed.
+type PushResult struct {
+	Branch          string
+	RebasedOntoMain bool
+	PRCreated       bool
+	PRURL           string
+}
+
+// PushBead pushes a bead's branch, rebasing onto the latest main first.
+// If the rebase conflicts, it aborts the rebase and opens a PR instead of
+// forcing a resolution, so parallel workers never stomp on main. The
+// whole sequence runs under Serialize so concurrent workers pushing
+// different beads in the same repo don't race on index.lock.
+func PushBead(repoDir, beadID string) (PushResult, error) {
+	return PushBeadAs(repoDir, beadID, AuthConfig{})
+}
+
+// PushBeadAs is PushBead with an explicit AuthConfig, for remotes that
+// need an SSH key or token credential helper rather than ambient
+// credentials.
+func PushBeadAs(repoDir, beadID string, auth AuthConfig) (PushResult, error) {
+	env, err := auth.Env()
+	if err != nil {
+		return PushResult{}, fmt.Errorf("preparing credentials for %s: %w", repoDir, err)
+	}
+
+	var result PushResult
+	err = Serialize(repoDir, 5, func() error {
+		var err error
+		result, err = pushBead(repoDir, beadID, env)
+		return err
+	})
+	return result, err
+}
+
+func pushBead(repoDir, beadID string, env []string) (PushResult, error) {
+	branch := "bead/" + beadID
+	result := PushResult{Branch: branch}
+
+	if err := runEnv(repoDir, env, "fetch", "origin", "main"); err != nil {
+		return result, authOrWrap(err, "fetching main")
+	}
+
+	rebaseErr := run(repoDir, "rebase", "origin/main")
+	if rebaseErr != nil {
+		_ = run(repoDir, "rebase", "--abort")
+		if err := runEnv(repoDir, env, "push", "-u", "origin", branch); err != nil {
+			return result, authOrWrap(err, fmt.Sprintf("pushing %s after rebase conflict", branch))
+		}
+		url, err := openPR(repoDir, branch, beadID)
+		if err != nil {
+			return result, fmt.Errorf("opening PR for %s after rebase conflict: %w", branch, err)
+		}
+		result.PRCreated = true
+		result.PRURL = url
+		return result, nil
+	}
+	result.RebasedOntoMain = true
+
+	if err := runEnv(repoDir, env, "push", "-u", "origin", branch); err != nil {
+		return result, authOrWrap(err, fmt.Sprintf("pushing %s", branch))
+	}
+	return result, nil
+}
+
+// authOrWrap turns a git failure that looks like a credential problem
+// into an *AuthError so callers get a clear signal instead of a generic
+// "pushed:false", and wraps anything else with msg as before.
+func authOrWrap(err error, msg string) error {
+	text := err.Error()
+	if strings.Contains(text, "Permission denied") ||
+		strings.Contains(text, "Authentication failed") ||
+		strings.Contains(text, "could not read Username") ||
+		strings.Contains(text, "terminal prompts disabled") {
+		return &AuthError{Remote: "origin", Err: fmt.Errorf("%s: %w", msg, err)}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+func openPR(repoDir, branch, beadID string) (string, error) {
+	cmd := exec.Command("gh", "pr", "create",
+		"--head", branch,
+		"--title", fmt.Sprintf("[%s] rebase conflict, needs manual merge", beadID),
+		"--body", "fire-flow could not rebase this bead's branch cleanly onto main; opened a PR instead of forcing a push.",
+	)
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func run(dir string, args ...string) error {
+	return runEnv(dir, nil, args...)
+}
+
+// runEnv is run with extra environment variables appended (e.g. from
+// AuthConfig.Env), inheriting the current process's environment
+// otherwise.
+func runEnv(dir string, extraEnv []string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if len(extraEnv) > 0 {
+		cmd.Env = append(os.Environ(), extraEnv...)
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package gitops
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// PushQueue is a plain-text, one-bead-per-line file of beads that
+// finished during quiet hours (see config.QuietHours) and are waiting
+// for `fire-flow flush` to push them in a batch.
+type PushQueue struct {
+	Path string
+}
+
+// NewPushQueue returns a PushQueue backed by path.
+func NewPushQueue(path string) *PushQueue {
+	return &PushQueue{Path: path}
+}
+
+// Enqueue appends beadID to the queue, ready for a later flush.
+func (q *PushQueue) Enqueue(beadID string) error {
+	f, err := os.OpenFile(q.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening push queue %s: %w", q.Path, err)
+	}
+	defer f.Close()
+	if _, err := fmt.Fprintln(f, beadID); err != nil {
+		return fmt.Errorf("writing to push queue %s: %w", q.Path, err)
+	}
+	return nil
+}
+
+// Drain reads every queued bead ID and truncates the queue file, so a
+// concurrent Enqueue during a flush can't be silently lost or
+// double-pushed.
+func (q *PushQueue) Drain() ([]string, error) {
+	f, err := os.Open(q.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading push queue %s: %w", q.Path, err)
+	}
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			ids = append(ids, line)
+		}
+	}
+	f.Close()
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning push queue %s: %w", q.Path, err)
+	}
+	if err := os.Remove(q.Path); err != nil {
+		return nil, fmt.Errorf("clearing push queue %s: %w", q.Path, err)
+	}
+	return ids, nil
+}
+
+// Flush drains the queue and pushes each bead in order, stopping and
+// re-queuing the remainder on the first failure so a bad push doesn't
+// lose track of the beads behind it.
+func Flush(repoDir string, q *PushQueue) ([]PushResult, error) {
+	ids, err := q.Drain()
+	if err != nil {
+		return nil, err
+	}
+	var results []PushResult
+	for i, id := range ids {
+		result, err := PushBead(repoDir, id)
+		if err != nil {
+			for _, remaining := range ids[i:] {
+				if reErr := q.Enqueue(remaining); reErr != nil {
+					return results, fmt.Errorf("pushing %s failed (%v), and re-queuing remaining beads failed: %w", id, err, reErr)
+				}
+			}
+			return results, fmt.Errorf("pushing %s: %w", id, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,50 @@
+package gitops
+
+import "fmt"
+
+// RemoteTarget is one additional remote a bead's branch should be
+// mirrored to, alongside the primary "origin" push PushBead already
+// does. Branch lets a mirror use a different branch naming scheme than
+// origin (e.g. an internal Gitea mirror prefixing with a team name).
+type RemoteTarget struct {
+	Remote string
+	Branch string // empty means use the same branch name as origin
+}
+
+// RemoteResult reports one mirror's outcome, independent of the others:
+// a Gitea outage shouldn't stop the GitHub mirror from succeeding.
+type RemoteResult struct {
+	Remote string
+	Branch string
+	Error  string // empty on success
+}
+
+// PushBeadToRemotes pushes a bead's branch via PushBeadAs (which owns
+// the rebase-or-PR decision against origin) and then replicates the
+// same branch to every configured mirror, in order, continuing past a
+// failed mirror so one down remote doesn't block the others.
+func PushBeadToRemotes(repoDir, beadID string, mirrors []RemoteTarget, auth AuthConfig) (PushResult, []RemoteResult, error) {
+	result, err := PushBeadAs(repoDir, beadID, auth)
+	if err != nil {
+		return result, nil, err
+	}
+
+	env, err := auth.Env()
+	if err != nil {
+		return result, nil, fmt.Errorf("preparing credentials for mirrors of %s: %w", repoDir, err)
+	}
+
+	results := make([]RemoteResult, 0, len(mirrors))
+	for _, mirror := range mirrors {
+		branch := mirror.Branch
+		if branch == "" {
+			branch = result.Branch
+		}
+		rr := RemoteResult{Remote: mirror.Remote, Branch: branch}
+		if err := runEnv(repoDir, env, "push", "-u", mirror.Remote, result.Branch+":"+branch); err != nil {
+			rr.Error = authOrWrap(err, fmt.Sprintf("pushing %s to mirror %s", result.Branch, mirror.Remote)).Error()
+		}
+		results = append(results, rr)
+	}
+	return result, results, nil
+}
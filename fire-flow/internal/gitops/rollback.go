@@ -0,0 +1,32 @@
+package gitops
+
+import "fmt"
+
+// RollbackBead reverts every commit a bead produced (newest first, so the
+// reverts apply cleanly against history), pushing the resulting revert
+// commits, and returns the reverted SHAs in that order. Use this when a bead's changes pass their own tests but later
+// turn out to break something downstream.
+func RollbackBead(repoDir, beadID string) ([]string, error) {
+	shas, err := CommitsForBead(repoDir, beadID)
+	if err != nil {
+		return nil, err
+	}
+	if len(shas) == 0 {
+		return nil, fmt.Errorf("no commits found for bead %s", beadID)
+	}
+
+	// git revert applies most-recent-first so each revert lands on top
+	// of the last; reverse the chronological list we got back.
+	for i, j := 0, len(shas)-1; i < j; i, j = i+1, j-1 {
+		shas[i], shas[j] = shas[j], shas[i]
+	}
+	for _, sha := range shas {
+		if err := run(repoDir, "revert", "--no-edit", sha); err != nil {
+			return nil, fmt.Errorf("reverting %s for bead %s: %w", sha, beadID, err)
+		}
+	}
+	if err := run(repoDir, "push", "origin", "HEAD"); err != nil {
+		return nil, fmt.Errorf("pushing rollback for bead %s: %w", beadID, err)
+	}
+	return shas, nil
+}
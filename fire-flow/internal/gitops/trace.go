@@ -0,0 +1,76 @@
+package gitops
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// beadTrailer is the git trailer fire-flow appends to every commit it
+// makes on a bead's behalf, so `git log --grep` (see CommitsForBead) can
+// always recover which bead produced a given commit.
+const beadTrailer = "Fire-Flow-Bead"
+
+// CommitMessage builds a commit message with a bead trailer, so every
+// commit fire-flow makes is traceable back to the bead that produced it
+// without needing a side-channel database.
+func CommitMessage(subject, beadID string) string {
+	return fmt.Sprintf("%s\n\n%s: %s\n", subject, beadTrailer, beadID)
+}
+
+// CommitsForBead returns the SHAs of every commit whose trailer names
+// beadID, oldest first.
+func CommitsForBead(repoDir, beadID string) ([]string, error) {
+	out, err := output(repoDir, "log", "--all", "--format=%H", "--grep", fmt.Sprintf("%s: %s", beadTrailer, beadID))
+	if err != nil {
+		return nil, fmt.Errorf("finding commits for bead %s: %w", beadID, err)
+	}
+	if out == "" {
+		return nil, nil
+	}
+	// --grep matches substrings, so "bead-1" also finds bead-10's commits;
+	// keep only those whose trailer names beadID exactly.
+	var lines []string
+	for _, sha := range strings.Split(out, "\n") {
+		id, err := BeadForCommit(repoDir, sha)
+		if err != nil {
+			return nil, err
+		}
+		if id == beadID {
+			lines = append(lines, sha)
+		}
+	}
+	// git log lists newest-first; reverse for chronological order.
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines, nil
+}
+
+// BeadForCommit extracts the bead ID trailer from a commit, if present.
+func BeadForCommit(repoDir, sha string) (string, error) {
+	out, err := output(repoDir, "show", "-s", "--format=%B", sha)
+	if err != nil {
+		return "", fmt.Errorf("reading commit %s: %w", sha, err)
+	}
+	prefix := beadTrailer + ": "
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, prefix) {
+			return strings.TrimPrefix(line, prefix), nil
+		}
+	}
+	return "", nil
+}
+
+// output runs a git command in dir and returns its trimmed stdout, for
+// callers that need to read git's output rather than just check whether
+// it succeeded (see run/runEnv in push.go for the error-only equivalent).
+func output(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
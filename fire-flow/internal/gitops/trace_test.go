@@ -0,0 +1,99 @@
+package gitops
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// initTestRepo creates a scratch git repo with a local identity so
+// commits don't depend on the host's global git config.
+func initTestRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "fire-flow@example.com"},
+		{"config", "user.name", "fire-flow"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	return dir
+}
+
+func commitFile(t *testing.T, dir, name, message string) string {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+	if err := run(dir, "add", name); err != nil {
+		t.Fatalf("git add %s: %v", name, err)
+	}
+	if err := run(dir, "commit", "-m", message); err != nil {
+		t.Fatalf("git commit: %v", err)
+	}
+	sha, err := output(dir, "rev-parse", "HEAD")
+	if err != nil {
+		t.Fatalf("git rev-parse HEAD: %v", err)
+	}
+	return sha
+}
+
+func TestCommitsForBeadRoundTrip(t *testing.T) {
+	dir := initTestRepo(t)
+	first := commitFile(t, dir, "a.txt", CommitMessage("do a thing", "bead-1"))
+	commitFile(t, dir, "b.txt", CommitMessage("unrelated", "bead-2"))
+	second := commitFile(t, dir, "c.txt", CommitMessage("do another thing", "bead-1"))
+
+	shas, err := CommitsForBead(dir, "bead-1")
+	if err != nil {
+		t.Fatalf("CommitsForBead: %v", err)
+	}
+	if len(shas) != 2 || shas[0] != first || shas[1] != second {
+		t.Fatalf("CommitsForBead(bead-1) = %v, want [%s %s] oldest first", shas, first, second)
+	}
+}
+
+func TestCommitsForBeadNoMatches(t *testing.T) {
+	dir := initTestRepo(t)
+	commitFile(t, dir, "a.txt", CommitMessage("do a thing", "bead-1"))
+
+	shas, err := CommitsForBead(dir, "bead-missing")
+	if err != nil {
+		t.Fatalf("CommitsForBead: %v", err)
+	}
+	if len(shas) != 0 {
+		t.Fatalf("CommitsForBead(bead-missing) = %v, want none", shas)
+	}
+}
+
+func TestBeadForCommit(t *testing.T) {
+	dir := initTestRepo(t)
+	sha := commitFile(t, dir, "a.txt", CommitMessage("do a thing", "bead-1"))
+
+	beadID, err := BeadForCommit(dir, sha)
+	if err != nil {
+		t.Fatalf("BeadForCommit: %v", err)
+	}
+	if beadID != "bead-1" {
+		t.Fatalf("BeadForCommit = %q, want %q", beadID, "bead-1")
+	}
+}
+
+func TestBeadForCommitNoTrailer(t *testing.T) {
+	dir := initTestRepo(t)
+	sha := commitFile(t, dir, "a.txt", "plain commit, no trailer")
+
+	beadID, err := BeadForCommit(dir, sha)
+	if err != nil {
+		t.Fatalf("BeadForCommit: %v", err)
+	}
+	if beadID != "" {
+		t.Fatalf("BeadForCommit = %q, want empty", beadID)
+	}
+}
@@ -0,0 +1,76 @@
+// Package gowork detects a go.work-based multi-module repo and runs
+// tests per member module, since `go test ./...` and naive package
+// impact analysis both only see the single module containing the
+// current directory, not a workspace's other members.
+package gowork
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Detect reports whether repoDir has a go.work file at its root, the
+// layout `go work init`/`go work use` always produces.
+func Detect(repoDir string) bool {
+	_, err := os.Stat(filepath.Join(repoDir, "go.work"))
+	return err == nil
+}
+
+// Modules parses go.work's `use` directives and returns each member
+// module's directory, relative to repoDir. It ignores `go`, `toolchain`,
+// and `replace` directives, none of which affect which modules exist.
+func Modules(repoDir string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(repoDir, "go.work"))
+	if err != nil {
+		return nil, fmt.Errorf("reading go.work: %w", err)
+	}
+	return parseUseDirectives(string(data)), nil
+}
+
+// parseUseDirectives handles both go.work's block form:
+//
+//	use (
+//		./a
+//		./b
+//	)
+//
+// and its single-line form (`use ./a`), which may appear any number of
+// times and even alongside a block.
+func parseUseDirectives(content string) []string {
+	var mods []string
+	inBlock := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if inBlock {
+			if trimmed == ")" {
+				inBlock = false
+				continue
+			}
+			if mod := cleanModulePath(trimmed); mod != "" {
+				mods = append(mods, mod)
+			}
+			continue
+		}
+		if trimmed == "use (" {
+			inBlock = true
+			continue
+		}
+		if strings.HasPrefix(trimmed, "use ") {
+			if mod := cleanModulePath(strings.TrimPrefix(trimmed, "use")); mod != "" {
+				mods = append(mods, mod)
+			}
+		}
+	}
+	return mods
+}
+
+// cleanModulePath strips a trailing `// comment`, surrounding quotes,
+// and whitespace from one use-directive entry.
+func cleanModulePath(raw string) string {
+	if i := strings.Index(raw, "//"); i >= 0 {
+		raw = raw[:i]
+	}
+	return strings.Trim(strings.TrimSpace(raw), `"`)
+}
@@ -0,0 +1,65 @@
+package gowork
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/lprior-repo/fire-flow/internal/teststate"
+)
+
+// RunTests runs `go test -json ./...` in each of repoDir's go.work
+// member modules and aggregates their output into one teststate.Result,
+// since a single `go test ./...` invocation at repoDir's root only sees
+// the module (if any) rooted there, not the workspace's other members.
+func RunTests(ctx context.Context, repoDir string) (teststate.Result, error) {
+	mods, err := Modules(repoDir)
+	if err != nil {
+		return teststate.Result{}, err
+	}
+
+	agg := teststate.Result{Packages: map[string]*teststate.PackageResult{}}
+	for _, mod := range mods {
+		res, err := runModuleTests(ctx, filepath.Join(repoDir, mod))
+		if err != nil {
+			return teststate.Result{}, fmt.Errorf("running tests for module %s: %w", mod, err)
+		}
+		mergeInto(&agg, res)
+	}
+	return agg, nil
+}
+
+func runModuleTests(ctx context.Context, moduleDir string) (teststate.Result, error) {
+	cmd := exec.CommandContext(ctx, "go", "test", "-json", "./...")
+	cmd.Dir = moduleDir
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return teststate.Result{}, fmt.Errorf("piping go test output: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return teststate.Result{}, fmt.Errorf("starting go test: %w", err)
+	}
+	res, parseErr := teststate.ParseGoTestOutputContext(ctx, stdout)
+	// A non-zero exit just means the module has failing tests, which
+	// res.FailedTests already captures; only a parse failure is
+	// reported as an error here.
+	_ = cmd.Wait()
+	if parseErr != nil {
+		return teststate.Result{}, fmt.Errorf("parsing go test output: %w", parseErr)
+	}
+	return res, nil
+}
+
+// mergeInto folds res's counts, failed tests, and per-package results
+// into agg.
+func mergeInto(agg *teststate.Result, res teststate.Result) {
+	agg.Passed += res.Passed
+	agg.Failed += res.Failed
+	agg.Skipped += res.Skipped
+	agg.UnparsableLines += res.UnparsableLines
+	agg.FailedTests = append(agg.FailedTests, res.FailedTests...)
+	for name, pkg := range res.Packages {
+		agg.Packages[name] = pkg
+	}
+}
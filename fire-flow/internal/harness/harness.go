@@ -0,0 +1,121 @@
+// Package harness spins up a throwaway project — a temp git repo laid
+// out like a fire-flow-managed one — so acceptance tests for the cycle
+// engine, scheduler, and other subsystems can run real fire-flow
+// subcommands in-process and assert on the resulting git history,
+// state.json, and beads file, without needing a container or a built
+// binary.
+package harness
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+	"github.com/lprior-repo/fire-flow/internal/cli"
+	"github.com/lprior-repo/fire-flow/internal/state"
+)
+
+// Project is a throwaway git repo, rooted at Dir, laid out the way
+// Provision leaves a freshly cloned worker host.
+type Project struct {
+	t   testing.TB
+	Dir string
+}
+
+// New creates a temp git repo with the .opencode/tcr layout fire-flow's
+// state store and history log expect, and an initial commit, tied to
+// t's lifetime (cleaned up automatically via t.TempDir).
+func New(t testing.TB) *Project {
+	t.Helper()
+	p := &Project{t: t, Dir: t.TempDir()}
+	p.mustGit("init", "-q")
+	for _, d := range []string{"tcr", filepath.Join("tcr", "backups"), filepath.Join("tcr", "scratch")} {
+		if err := os.MkdirAll(filepath.Join(p.Dir, ".opencode", d), 0o755); err != nil {
+			t.Fatalf("harness: creating .opencode/%s: %v", d, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(p.Dir, "README.md"), []byte("# scratch project\n"), 0o644); err != nil {
+		t.Fatalf("harness: seeding README: %v", err)
+	}
+	p.mustGit("add", "-A")
+	p.mustGit("-c", "user.email=harness@localhost", "-c", "user.name=harness", "commit", "-q", "-m", "initial")
+	return p
+}
+
+// Run executes cmd against args, the same cli.Command interface
+// fire-flow's own dispatch loop uses, so a test exercises exactly the
+// code path a real CLI invocation would.
+func (p *Project) Run(cmd cli.Command, args []string) error {
+	return cmd.Run(args)
+}
+
+// StatePath is where this project's state.json lives.
+func (p *Project) StatePath() string {
+	return filepath.Join(p.Dir, ".opencode", "tcr", "state.json")
+}
+
+// BeadsPath is where this project's beads JSONL lives.
+func (p *Project) BeadsPath() string {
+	return filepath.Join(p.Dir, ".beads", "issues.jsonl")
+}
+
+// State loads the project's current state.json, failing the test on a
+// read or parse error rather than returning one, so assertions read as
+// a single line: harness.New(t).State().Mode.
+func (p *Project) State() state.State {
+	p.t.Helper()
+	st, err := state.NewStore(p.StatePath(), 3).Load()
+	if err != nil {
+		p.t.Fatalf("harness: loading state: %v", err)
+	}
+	return st
+}
+
+// Beads loads the project's beads file, failing the test on error.
+func (p *Project) Beads() []bead.Issue {
+	p.t.Helper()
+	issues, err := bead.ReadAll(p.BeadsPath())
+	if err != nil {
+		p.t.Fatalf("harness: loading beads: %v", err)
+	}
+	return issues
+}
+
+// CommitCount returns how many commits are on the project's current
+// branch, for asserting a cycle actually committed (or didn't).
+func (p *Project) CommitCount() int {
+	p.t.Helper()
+	out, err := exec.Command("git", "-C", p.Dir, "rev-list", "--count", "HEAD").Output()
+	if err != nil {
+		p.t.Fatalf("harness: counting commits: %v", err)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(out)))
+	if err != nil {
+		p.t.Fatalf("harness: parsing commit count %q: %v", out, err)
+	}
+	return n
+}
+
+// LastCommitMessage returns HEAD's commit subject line, for asserting a
+// cycle's commit message shape (e.g. the "[bead-id] ..." convention).
+func (p *Project) LastCommitMessage() string {
+	p.t.Helper()
+	out, err := exec.Command("git", "-C", p.Dir, "log", "-1", "--format=%s").Output()
+	if err != nil {
+		p.t.Fatalf("harness: reading last commit message: %v", err)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func (p *Project) mustGit(args ...string) {
+	p.t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = p.Dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		p.t.Fatalf("harness: git %s: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+}
@@ -0,0 +1,84 @@
+package harness
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/lprior-repo/fire-flow/internal/bead"
+)
+
+func TestNewProvisionsProject(t *testing.T) {
+	p := New(t)
+
+	for _, d := range []string{"tcr", filepath.Join("tcr", "backups"), filepath.Join("tcr", "scratch")} {
+		if info, err := os.Stat(filepath.Join(p.Dir, ".opencode", d)); err != nil || !info.IsDir() {
+			t.Fatalf(".opencode/%s should exist as a directory, err=%v", d, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(p.Dir, "README.md")); err != nil {
+		t.Fatalf("README.md should exist: %v", err)
+	}
+	if got := p.CommitCount(); got != 1 {
+		t.Fatalf("CommitCount() = %d, want 1 (the initial commit)", got)
+	}
+	if got := p.LastCommitMessage(); got != "initial" {
+		t.Fatalf("LastCommitMessage() = %q, want %q", got, "initial")
+	}
+	if st := p.State(); st.Mode != "" || st.Paused {
+		t.Fatalf("State() on a fresh project = %+v, want zero value", st)
+	}
+	if beads := p.Beads(); len(beads) != 0 {
+		t.Fatalf("Beads() on a fresh project = %v, want none", beads)
+	}
+}
+
+// fileCommand is a minimal cli.Command that writes a bead proposal and
+// commits it, standing in for a real fire-flow subcommand (those live
+// in cmd/fire-flow, package main, and can't be imported here) so this
+// test exercises Project.Run's plumbing end to end.
+type fileCommand struct{ p *Project }
+
+func (fileCommand) Name() string     { return "fake" }
+func (fileCommand) Synopsis() string { return "test double for a fire-flow subcommand" }
+
+func (c fileCommand) Run(args []string) error {
+	if err := os.MkdirAll(filepath.Dir(c.p.BeadsPath()), 0o755); err != nil {
+		return err
+	}
+	if _, err := bead.AppendProposal(c.p.BeadsPath(), "test", "found a thing", "body", 1, 1); err != nil {
+		return err
+	}
+	cmd := exec.Command("git", "add", "-A")
+	cmd.Dir = c.p.Dir
+	if _, err := cmd.CombinedOutput(); err != nil {
+		return err
+	}
+	cmd = exec.Command("git", "-c", "user.email=harness@localhost", "-c", "user.name=harness", "commit", "-q", "-m", args[0])
+	cmd.Dir = c.p.Dir
+	if _, err := cmd.CombinedOutput(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func TestRunExecutesCommandAgainstTheProject(t *testing.T) {
+	p := New(t)
+	cmd := fileCommand{p: p}
+
+	if err := p.Run(cmd, []string{"[test-bead] file a proposal"}); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if got := p.CommitCount(); got != 2 {
+		t.Fatalf("CommitCount() after Run = %d, want 2", got)
+	}
+	if got := p.LastCommitMessage(); got != "[test-bead] file a proposal" {
+		t.Fatalf("LastCommitMessage() = %q, want the command's commit message", got)
+	}
+	beads := p.Beads()
+	if len(beads) != 1 || beads[0].Title != "found a thing" {
+		t.Fatalf("Beads() = %v, want the proposal fileCommand appended", beads)
+	}
+}
@@ -0,0 +1,90 @@
+// Package history reads fire-flow's JSONL event log (one cycle.Summary
+// per line, per the event-sourcing design in FIRE-FLOW_PLAN.md) for
+// querying via the CLI and REST API.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+)
+
+// Query filters and paginates a history read.
+type Query struct {
+	BeadID   string          // exact match, empty means any
+	Decision cycle.Decision  // exact match, empty means any
+	Offset   int
+	Limit    int // 0 means unlimited
+}
+
+// Page is a slice of matching entries plus whether more exist beyond it.
+type Page struct {
+	Entries []cycle.Summary
+	HasMore bool
+}
+
+// Read scans the JSONL event log at path and returns the page of entries
+// matching q, in file order (oldest first).
+func Read(path string, q Query) (Page, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return Page{}, nil
+	}
+	if err != nil {
+		return Page{}, fmt.Errorf("opening history log %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var matched []cycle.Summary
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry cycle.Summary
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return Page{}, fmt.Errorf("parsing history entry: %w", err)
+		}
+		if q.BeadID != "" && entry.BeadID != q.BeadID {
+			continue
+		}
+		if q.Decision != "" && entry.Decision != q.Decision {
+			continue
+		}
+		matched = append(matched, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return Page{}, fmt.Errorf("scanning history log %s: %w", path, err)
+	}
+
+	return paginate(matched, q.Offset, q.Limit), nil
+}
+
+func paginate(entries []cycle.Summary, offset, limit int) Page {
+	if offset > len(entries) {
+		offset = len(entries)
+	}
+	rest := entries[offset:]
+	if limit <= 0 || limit >= len(rest) {
+		return Page{Entries: rest, HasMore: false}
+	}
+	return Page{Entries: rest[:limit], HasMore: true}
+}
+
+// Append writes a new entry to the JSONL log, creating it if needed.
+func Append(path string, entry cycle.Summary) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening history log %s: %w", path, err)
+	}
+	defer f.Close()
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshaling history entry: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("appending history entry to %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Package i18n provides a small message catalog for fire-flow's
+// operator-facing strings — errors, status lines, gate messages — so an
+// operator can select a language via config or FIRE_FLOW_LANG and read
+// the CLI's prose in it. It never touches JSON field names or values
+// meant to be parsed by CI or other tooling; only text meant for a
+// human terminal goes through this package.
+package i18n
+
+import (
+	"fmt"
+	"os"
+)
+
+// Key identifies one catalog entry. Translating a closed set of keys,
+// rather than raw strings, names every translatable message in one
+// place; a key a language's catalog lacks is not a compile error and
+// falls back to the English entry at run time (see Message).
+type Key string
+
+const (
+	KeyGateBlockedGreen Key = "gate.blocked_green"
+	KeyGateDocsOnly     Key = "gate.docs_only"
+	KeyCycleCommitted   Key = "cycle.committed"
+	KeyCycleReverted    Key = "cycle.reverted"
+	KeyStatusOK         Key = "status.ok"
+	KeyStatusFail       Key = "status.fail"
+)
+
+// catalog maps a language tag ("en", "es", ...) to its Key -> template
+// string. "en" is the fallback used for any language or key a more
+// specific catalog is missing.
+var catalog = map[string]map[Key]string{
+	"en": {
+		KeyGateBlockedGreen: "gate: no source changes allowed while GREEN",
+		KeyGateDocsOnly:     "docs-only change: fast path",
+		KeyCycleCommitted:   "committed",
+		KeyCycleReverted:    "reverted",
+		KeyStatusOK:         "OK",
+		KeyStatusFail:       "FAIL",
+	},
+	"es": {
+		KeyGateBlockedGreen: "puerta: no se permiten cambios de código fuente en GREEN",
+		KeyGateDocsOnly:     "cambio solo de documentación: vía rápida",
+		KeyCycleCommitted:   "confirmado",
+		KeyCycleReverted:    "revertido",
+		KeyStatusOK:         "OK",
+		KeyStatusFail:       "FALLÓ",
+	},
+}
+
+// Language resolves the active language tag: an explicit configured
+// value first, then the FIRE_FLOW_LANG environment variable, then "en".
+func Language(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	if env := os.Getenv("FIRE_FLOW_LANG"); env != "" {
+		return env
+	}
+	return "en"
+}
+
+// Message renders key in lang, falling back to the English catalog when
+// lang has no entry for key, and to the key itself when even English is
+// missing it, so a caller always gets something printable rather than
+// an empty string.
+func Message(lang string, key Key, args ...any) string {
+	if tmpl, ok := lookup(lang, key); ok {
+		return format(tmpl, args)
+	}
+	if tmpl, ok := lookup("en", key); ok {
+		return format(tmpl, args)
+	}
+	return string(key)
+}
+
+func lookup(lang string, key Key) (string, bool) {
+	messages, ok := catalog[lang]
+	if !ok {
+		return "", false
+	}
+	tmpl, ok := messages[key]
+	return tmpl, ok
+}
+
+func format(tmpl string, args []any) string {
+	if len(args) == 0 {
+		return tmpl
+	}
+	return fmt.Sprintf(tmpl, args...)
+}
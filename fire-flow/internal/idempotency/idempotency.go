@@ -0,0 +1,120 @@
+// Package idempotency lets orchestration commands (run-ai and friends,
+// triggered by Kestra) accept a caller-supplied execution ID and record
+// completed operations against it, so a retried call returns the
+// previous result instead of re-processing the same bead.
+package idempotency
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Record is one completed operation, keyed by its execution ID.
+type Record struct {
+	Result json.RawMessage `json:"result"`
+}
+
+// Store persists completed operations to a single JSON file. It is safe
+// for concurrent use from one process; callers spanning multiple
+// processes should point separate Stores at separate paths, the same
+// convention internal/state.Store uses for its state file.
+type Store struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewStore returns a Store backed by path, created on first Put if it
+// doesn't exist yet.
+func NewStore(path string) *Store {
+	return &Store{Path: path}
+}
+
+// Get returns the recorded result for key, if any.
+func (s *Store) Get(key string) (json.RawMessage, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.load()
+	if err != nil {
+		return nil, false, err
+	}
+	rec, ok := records[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return rec.Result, true, nil
+}
+
+// Put records result under key, overwriting any previous record for the
+// same key (a caller re-running after an explicit correction, not a
+// retry, is expected to overwrite).
+func (s *Store) Put(key string, result any) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("marshaling idempotency result for %s: %w", key, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+	if records == nil {
+		records = map[string]Record{}
+	}
+	records[key] = Record{Result: data}
+	return s.save(records)
+}
+
+func (s *Store) load() (map[string]Record, error) {
+	data, err := os.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return map[string]Record{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading idempotency store %s: %w", s.Path, err)
+	}
+	var records map[string]Record
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("parsing idempotency store %s: %w", s.Path, err)
+	}
+	return records, nil
+}
+
+func (s *Store) save(records map[string]Record) error {
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling idempotency store: %w", err)
+	}
+	if err := os.WriteFile(s.Path, data, 0o644); err != nil {
+		return fmt.Errorf("writing idempotency store %s: %w", s.Path, err)
+	}
+	return nil
+}
+
+// Once runs fn only if key has no recorded result yet, returning the
+// recorded (or freshly computed) result either way. Callers pass the
+// same key on retry (their orchestrator's execution ID) to get
+// exactly-once semantics for at-least-once delivery.
+func Once[T any](s *Store, key string, fn func() (T, error)) (T, error) {
+	var zero T
+	if raw, ok, err := s.Get(key); err != nil {
+		return zero, err
+	} else if ok {
+		var result T
+		if err := json.Unmarshal(raw, &result); err != nil {
+			return zero, fmt.Errorf("decoding recorded result for %s: %w", key, err)
+		}
+		return result, nil
+	}
+	result, err := fn()
+	if err != nil {
+		return zero, err
+	}
+	if err := s.Put(key, result); err != nil {
+		return zero, err
+	}
+	return result, nil
+}
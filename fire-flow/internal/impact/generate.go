@@ -0,0 +1,75 @@
+package impact
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// generateDirectivePrefix is the exact marker `go generate` itself
+// looks for: "//go:generate" with no space before the colon.
+const generateDirectivePrefix = "//go:generate "
+
+// generateDirectives scans every non-test .go file in each of pkgDirs
+// for //go:generate directives, returning each one prefixed with its
+// package dir so callers know where to run it from.
+func generateDirectives(repoDir string, pkgDirs []string) ([]string, error) {
+	var directives []string
+	for _, pkgDir := range pkgDirs {
+		files, _ := filepath.Glob(filepath.Join(repoDir, pkgDir, "*.go"))
+		for _, f := range files {
+			if strings.HasSuffix(f, "_test.go") {
+				continue
+			}
+			found, err := scanGenerateDirectives(f)
+			if err != nil {
+				return nil, err
+			}
+			for _, d := range found {
+				directives = append(directives, pkgDir+": "+d)
+			}
+		}
+	}
+	return directives, nil
+}
+
+// scanGenerateDirectives returns the command text of every
+// //go:generate line in path, without the directive marker itself.
+func scanGenerateDirectives(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var directives []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, generateDirectivePrefix) {
+			directives = append(directives, strings.TrimSpace(strings.TrimPrefix(line, generateDirectivePrefix)))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning %s for go:generate directives: %w", path, err)
+	}
+	return directives, nil
+}
+
+// RunGenerate reruns `go generate` scoped to each of impact's affected
+// packages, inside the overlay session rooted at overlayDir, so
+// generated code stays in sync with the .c/.h or go:generate sources
+// that changed rather than testing against stale output.
+func RunGenerate(overlayDir string, impactResult Impact) error {
+	for _, pkgDir := range impactResult.AffectedPackages {
+		cmd := exec.Command("go", "generate", "./...")
+		cmd.Dir = filepath.Join(overlayDir, pkgDir)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("go generate in %s: %w: %s", pkgDir, err, strings.TrimSpace(string(out)))
+		}
+	}
+	return nil
+}
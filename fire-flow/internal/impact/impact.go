@@ -0,0 +1,113 @@
+// Package impact maps a set of changed files to the Go packages and
+// tests affected by them, so a local targeted-test strategy can be
+// mirrored by an external CI pipeline instead of it always running the
+// full suite.
+package impact
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Impact is the change -> test mapping for one commit range.
+type Impact struct {
+	From             string   `json:"from"`
+	To               string   `json:"to"`
+	ChangedFiles     []string `json:"changed_files"`
+	AffectedPackages []string `json:"affected_packages"`
+	AffectedTests    []string `json:"affected_tests"`
+	// GenerateDirectives lists the //go:generate lines found in any
+	// affected package, so a caller can choose to rerun them (see
+	// RunGenerate) before testing rather than testing stale generated
+	// code.
+	GenerateDirectives []string `json:"generate_directives,omitempty"`
+}
+
+// ChangedFiles returns the files that differ between from and to.
+func ChangedFiles(repoDir, from, to string) ([]string, error) {
+	cmd := exec.Command("git", "diff", "--name-only", from, to)
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("diffing %s..%s: %w", from, to, err)
+	}
+	var files []string
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// Analyze builds an Impact for the commit range, resolving each changed
+// Go file to its package directory and any _test.go files alongside it
+// (fire-flow's own convention: tests live next to the code they cover,
+// see LANG_GO.md), plus every named Test function found there.
+func Analyze(repoDir, from, to string) (Impact, error) {
+	changed, err := ChangedFiles(repoDir, from, to)
+	if err != nil {
+		return Impact{}, err
+	}
+
+	pkgSet := map[string]bool{}
+	var tests []string
+	for _, rel := range changed {
+		// A changed .c/.h file affects whichever Go package cgo-compiles
+		// it, and by this repo's convention (and cgo's own requirement)
+		// that's always the package directory the source file sits in,
+		// not something a Go changed-file check alone would ever catch.
+		if ext := filepath.Ext(rel); ext != ".go" && ext != ".c" && ext != ".h" {
+			continue
+		}
+		pkgDir := filepath.Dir(rel)
+		pkgSet[pkgDir] = true
+
+		testFiles, _ := filepath.Glob(filepath.Join(repoDir, pkgDir, "*_test.go"))
+		for _, tf := range testFiles {
+			names, err := testFunctionNames(tf)
+			if err != nil {
+				return Impact{}, err
+			}
+			tests = append(tests, names...)
+		}
+	}
+
+	pkgs := make([]string, 0, len(pkgSet))
+	for pkg := range pkgSet {
+		pkgs = append(pkgs, pkg)
+	}
+
+	directives, err := generateDirectives(repoDir, pkgs)
+	if err != nil {
+		return Impact{}, err
+	}
+
+	return Impact{From: from, To: to, ChangedFiles: changed, AffectedPackages: pkgs, AffectedTests: tests, GenerateDirectives: directives}, nil
+}
+
+// testFunctionNames returns every top-level `func TestXxx(t *testing.T)`
+// name declared in a test file.
+func testFunctionNames(path string) ([]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	var names []string
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil {
+			continue
+		}
+		if strings.HasPrefix(fn.Name.Name, "Test") {
+			names = append(names, fn.Name.Name)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,169 @@
+// Package leader implements lease-based leader election over a shared
+// lock file, so multiple daemon instances pointed at the same repo's
+// beads store agree on exactly one active dispatcher while the rest sit
+// in hot standby instead of double-processing the same bead.
+package leader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// staleLockTimeout bounds how long a companion lock file (see withLock)
+// is honored after its creation: TryAcquire's critical section is a
+// couple of filesystem calls, so anything holding the lock much longer
+// than that means the process that created it crashed mid-election
+// rather than that it's doing legitimate work.
+const staleLockTimeout = 10 * time.Second
+
+// withLock runs fn while holding an exclusive, `O_CREATE|O_EXCL`-based
+// lock on path+".lock", so two Electors racing TryAcquire/Release at the
+// same instant serialize instead of both reading the lease before
+// either writes it. A lock file older than staleLockTimeout is assumed
+// to be left behind by a crashed process and is reclaimed.
+func withLock(path string, fn func() error) error {
+	lockPath := path + ".lock"
+	const (
+		retries = 20
+		backoff = 5 * time.Millisecond
+	)
+	var lastErr error
+	for attempt := 0; attempt < retries; attempt++ {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			f.Close()
+			defer os.Remove(lockPath)
+			return fn()
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("acquiring lock %s: %w", lockPath, err)
+		}
+		lastErr = err
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > staleLockTimeout {
+			os.Remove(lockPath)
+			continue
+		}
+		time.Sleep(backoff)
+	}
+	return fmt.Errorf("acquiring lock %s: %w (held by another election attempt for too long)", lockPath, lastErr)
+}
+
+// Lease records who currently holds leadership and until when.
+type Lease struct {
+	HolderID   string    `json:"holder_id"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Expired reports whether the lease is no longer valid as of now, so a
+// crashed leader's lock file doesn't block election forever.
+func (l Lease) Expired(now time.Time) bool {
+	return !l.ExpiresAt.After(now)
+}
+
+// Elector holds (or contends for) the lease at Path under HolderID,
+// renewing it for TTL each time TryAcquire succeeds.
+type Elector struct {
+	Path     string
+	HolderID string
+	TTL      time.Duration
+}
+
+// NewElector returns an Elector for the given shared lock file path.
+func NewElector(path, holderID string, ttl time.Duration) *Elector {
+	return &Elector{Path: path, HolderID: holderID, TTL: ttl}
+}
+
+// TryAcquire attempts to become (or renew being) leader, returning
+// whether e.HolderID holds the lease after the call. Aside from briefly
+// serializing against another Elector's concurrent TryAcquire/Release
+// (see withLock), it doesn't wait out a live lease held by someone
+// else: that case just returns false.
+func (e *Elector) TryAcquire() (bool, error) {
+	var acquired bool
+	err := withLock(e.Path, func() error {
+		current, err := readLease(e.Path)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if current != nil && !current.Expired(now) && current.HolderID != e.HolderID {
+			return nil
+		}
+		lease := Lease{HolderID: e.HolderID, AcquiredAt: now, ExpiresAt: now.Add(e.TTL)}
+		if err := writeLease(e.Path, lease); err != nil {
+			return err
+		}
+		acquired = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return acquired, nil
+}
+
+// Release gives up leadership early (e.g. on graceful shutdown) by
+// writing an already-expired lease, so a standby doesn't wait out the
+// full TTL before taking over. It's a no-op if e isn't the current
+// holder.
+func (e *Elector) Release() error {
+	return withLock(e.Path, func() error {
+		current, err := readLease(e.Path)
+		if err != nil {
+			return err
+		}
+		if current == nil || current.HolderID != e.HolderID {
+			return nil
+		}
+		expired := *current
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+		return writeLease(e.Path, expired)
+	})
+}
+
+// IsLeader reports whether e currently holds a live lease, without
+// attempting to acquire or renew one.
+func (e *Elector) IsLeader() (bool, error) {
+	current, err := readLease(e.Path)
+	if err != nil {
+		return false, err
+	}
+	return current != nil && current.HolderID == e.HolderID && !current.Expired(time.Now()), nil
+}
+
+func readLease(path string) (*Lease, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading leader lease %s: %w", path, err)
+	}
+	var lease Lease
+	if err := json.Unmarshal(data, &lease); err != nil {
+		return nil, fmt.Errorf("parsing leader lease %s: %w", path, err)
+	}
+	return &lease, nil
+}
+
+// writeLease writes to a temp file and renames it into place, so a
+// racing reader on another host's shared filesystem never observes a
+// partially-written lease.
+func writeLease(path string, lease Lease) error {
+	data, err := json.MarshalIndent(lease, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling leader lease: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing leader lease %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("committing leader lease %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package leader
+
+import (
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestTryAcquireConcurrentIsExclusive races many Electors for a lease
+// that's initially unclaimed, the exact scenario the package doc
+// promises exactly one winner for. Without withLock serializing the
+// read-then-write, this test is flaky (more than one Elector sees "no
+// lease held" and both write themselves in as holder).
+func TestTryAcquireConcurrentIsExclusive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+
+	const contenders = 20
+	var wg sync.WaitGroup
+	var acquired int64
+	for i := 0; i < contenders; i++ {
+		e := NewElector(path, holderName(i), time.Minute)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, err := e.TryAcquire()
+			if err != nil {
+				t.Errorf("TryAcquire: %v", err)
+				return
+			}
+			if ok {
+				atomic.AddInt64(&acquired, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if acquired != 1 {
+		t.Fatalf("acquired = %d, want exactly 1 winner among %d contenders", acquired, contenders)
+	}
+}
+
+func TestTryAcquireRenewsForCurrentHolder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	e := NewElector(path, "holder-1", time.Minute)
+
+	ok, err := e.TryAcquire()
+	if err != nil || !ok {
+		t.Fatalf("first TryAcquire: ok=%v err=%v", ok, err)
+	}
+	ok, err = e.TryAcquire()
+	if err != nil || !ok {
+		t.Fatalf("renewal TryAcquire: ok=%v err=%v", ok, err)
+	}
+}
+
+func TestReleaseAllowsOtherHolderToAcquire(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	first := NewElector(path, "holder-1", time.Minute)
+	second := NewElector(path, "holder-2", time.Minute)
+
+	if ok, err := first.TryAcquire(); err != nil || !ok {
+		t.Fatalf("first TryAcquire: ok=%v err=%v", ok, err)
+	}
+	if ok, err := second.TryAcquire(); err != nil || ok {
+		t.Fatalf("second TryAcquire before release: ok=%v err=%v, want false", ok, err)
+	}
+	if err := first.Release(); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if ok, err := second.TryAcquire(); err != nil || !ok {
+		t.Fatalf("second TryAcquire after release: ok=%v err=%v, want true", ok, err)
+	}
+}
+
+func holderName(i int) string {
+	return "holder-" + string(rune('a'+i))
+}
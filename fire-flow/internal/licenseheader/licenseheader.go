@@ -0,0 +1,70 @@
+// Package licenseheader enforces that files committed through fire-flow
+// carry a required license header, either inserting it automatically or
+// failing the commit when one is missing.
+package licenseheader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Policy maps a file extension (including the leading dot, e.g. ".go")
+// to the literal header text required at the top of every file with
+// that extension. An extension absent from Templates is never checked.
+type Policy struct {
+	Templates map[string]string
+	// AutoInsert, when true, prepends the missing header instead of
+	// reporting a Violation.
+	AutoInsert bool
+}
+
+// Violation names a file missing its required header.
+type Violation struct {
+	File string
+	Ext  string
+}
+
+// Result is the outcome of applying a Policy to a set of files.
+type Result struct {
+	Inserted   []string
+	Violations []Violation
+}
+
+// Apply checks (and, per policy.AutoInsert, fixes) every file in rel
+// (paths relative to baseDir, as returned by overlay.Diff) whose
+// extension is covered by policy.Templates. A file already containing
+// its template anywhere in its contents is left untouched, so
+// re-running Apply on an already-headered file is a no-op.
+func Apply(baseDir string, rel []string, policy Policy) (Result, error) {
+	var result Result
+	for _, r := range rel {
+		ext := filepath.Ext(r)
+		template, ok := policy.Templates[ext]
+		if !ok {
+			continue
+		}
+		path := filepath.Join(baseDir, r)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return result, fmt.Errorf("reading %s for license header check: %w", r, err)
+		}
+		if strings.Contains(string(data), template) {
+			continue
+		}
+		if !policy.AutoInsert {
+			result.Violations = append(result.Violations, Violation{File: r, Ext: ext})
+			continue
+		}
+		updated := template + "\n" + string(data)
+		if err := os.WriteFile(path, []byte(updated), 0o644); err != nil {
+			return result, fmt.Errorf("inserting license header into %s: %w", r, err)
+		}
+		result.Inserted = append(result.Inserted, r)
+	}
+	return result, nil
+}
@@ -0,0 +1,28 @@
+package licenseheader
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Verdict mirrors gate.Verdict's shape without importing internal/gate,
+// the same decoupling depcheck.Verdict, vulncheck.Verdict, and
+// secretscan.Verdict use.
+type Verdict struct {
+	Allow   bool
+	Message string
+}
+
+// CheckResult blocks the commit when Apply found any file missing its
+// required header (Violations is only ever populated when AutoInsert
+// was false, so this is a no-op when auto-insertion is enabled).
+func CheckResult(result Result) Verdict {
+	if len(result.Violations) == 0 {
+		return Verdict{Allow: true}
+	}
+	files := make([]string, 0, len(result.Violations))
+	for _, v := range result.Violations {
+		files = append(files, v.File)
+	}
+	return Verdict{Allow: false, Message: fmt.Sprintf("missing required license header: %s", strings.Join(files, ", "))}
+}
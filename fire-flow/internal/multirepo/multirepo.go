@@ -0,0 +1,92 @@
+// Package multirepo coordinates a bead whose work spans more than one
+// repository (see bead.Issue's Repos field): it provisions an overlay
+// session per repo, hands the AI runner every session's checked-out
+// path together, and lands the change as a single atomic unit — either
+// every repo's commit lands, or every repo that already landed gets
+// rolled back.
+package multirepo
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/gitops"
+	"github.com/lprior-repo/fire-flow/internal/provision"
+)
+
+// RepoRef names one repository a cross-repo bead touches.
+type RepoRef struct {
+	Name    string // short label used as the session's subdirectory, e.g. "api"
+	RepoURL string
+	Module  string // sparse-checkout scope, relative to that repo's root
+}
+
+// Session pairs a RepoRef with its provisioned worktree.
+type Session struct {
+	Repo    RepoRef
+	DestDir string
+}
+
+// ProvisionAll provisions baseOpts (RepoURL and Module overridden per
+// repo, DestDir nested under baseOpts.DestDir/<name>) for each of repos,
+// stopping at the first failure. It returns every session provisioned
+// so far even on error, so the caller can still clean up partial state.
+func ProvisionAll(baseOpts provision.Options, repos []RepoRef) ([]Session, error) {
+	sessions := make([]Session, 0, len(repos))
+	for _, r := range repos {
+		opts := baseOpts
+		opts.RepoURL = r.RepoURL
+		opts.Module = r.Module
+		opts.DestDir = filepath.Join(baseOpts.DestDir, r.Name)
+
+		result, err := provision.Provision(opts)
+		if err != nil {
+			return sessions, fmt.Errorf("provisioning repo %s: %w", r.Name, err)
+		}
+		sessions = append(sessions, Session{Repo: r, DestDir: result.DestDir})
+	}
+	return sessions, nil
+}
+
+// MergedPaths returns every session's checked-out path, in provisioning
+// order, for an AI prompt that needs to see and edit all of them
+// together.
+func MergedPaths(sessions []Session) []string {
+	paths := make([]string, len(sessions))
+	for i, s := range sessions {
+		paths[i] = s.DestDir
+	}
+	return paths
+}
+
+// CommitAll pushes beadID's changes in every session in order. If any
+// repo fails to push, every repo that already landed is rolled back via
+// gitops.RollbackBead before CommitAll returns the original error, so a
+// cross-repo bead never lands partially.
+func CommitAll(sessions []Session, beadID string) error {
+	var landed []string
+	for _, s := range sessions {
+		if _, err := gitops.PushBead(s.DestDir, beadID); err != nil {
+			if rbErr := rollback(landed, beadID); rbErr != nil {
+				return fmt.Errorf("repo %s failed to push (%w), and rolling back %d already-landed repo(s) also failed: %v", s.Repo.Name, err, len(landed), rbErr)
+			}
+			return fmt.Errorf("repo %s failed to push, rolled back %d already-landed repo(s): %w", s.Repo.Name, len(landed), err)
+		}
+		landed = append(landed, s.DestDir)
+	}
+	return nil
+}
+
+func rollback(destDirs []string, beadID string) error {
+	var errs []string
+	for _, dir := range destDirs {
+		if _, err := gitops.RollbackBead(dir, beadID); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", dir, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package mutation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// cacheKey identifies a mutant outcome by the state it was produced from:
+// the mutated file's content, the mutator that produced it, and the set of
+// tests it was run against. Any change to one of these invalidates the
+// cached outcome.
+type cacheKey struct {
+	File    FileHash    `json:"file"`
+	Mutator MutatorID   `json:"mutator"`
+	TestSet TestSetHash `json:"test_set"`
+	Line    int         `json:"line"`
+	Col     int         `json:"col"`
+}
+
+// Cache is an on-disk store of mutant outcomes, keyed by (file hash,
+// mutator, test set hash). It lets `fire-flow mutate` skip re-running
+// mutants whose inputs haven't changed since the last cycle.
+type Cache struct {
+	path    string
+	entries map[cacheKey]Outcome
+}
+
+// LoadCache reads a mutation cache from path, returning an empty cache if
+// the file does not yet exist.
+func LoadCache(path string) (*Cache, error) {
+	c := &Cache{path: path, entries: make(map[cacheKey]Outcome)}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading mutation cache %s: %w", path, err)
+	}
+	var raw []struct {
+		Key     cacheKey `json:"key"`
+		Outcome Outcome  `json:"outcome"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing mutation cache %s: %w", path, err)
+	}
+	for _, entry := range raw {
+		c.entries[entry.Key] = entry.Outcome
+	}
+	return c, nil
+}
+
+// Save writes the cache to disk atomically (write to a temp file, then
+// rename) so a crash mid-write never corrupts the cache.
+func (c *Cache) Save() error {
+	raw := make([]struct {
+		Key     cacheKey `json:"key"`
+		Outcome Outcome  `json:"outcome"`
+	}, 0, len(c.entries))
+	for k, v := range c.entries {
+		raw = append(raw, struct {
+			Key     cacheKey `json:"key"`
+			Outcome Outcome  `json:"outcome"`
+		}{k, v})
+	}
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling mutation cache: %w", err)
+	}
+	tmp := c.path + ".tmp"
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("creating mutation cache dir: %w", err)
+	}
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing mutation cache %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("finalizing mutation cache %s: %w", c.path, err)
+	}
+	return nil
+}
+
+// Lookup returns the cached outcome for a mutant, if one exists for the
+// given file/test-set state.
+func (c *Cache) Lookup(m Mutant, file FileHash, testSet TestSetHash) (Outcome, bool) {
+	o, ok := c.entries[cacheKey{File: file, Mutator: m.Mutator, TestSet: testSet, Line: m.Line, Col: m.Col}]
+	return o, ok
+}
+
+// Record stores the outcome of running a mutant against the current
+// file/test-set state.
+func (c *Cache) Record(m Mutant, file FileHash, testSet TestSetHash, outcome Outcome) {
+	c.entries[cacheKey{File: file, Mutator: m.Mutator, TestSet: testSet, Line: m.Line, Col: m.Col}] = outcome
+}
+
+// HashFile returns the sha256 content hash of a file, for use as a cache
+// key component.
+func HashFile(path string) (FileHash, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("hashing %s: %w", path, err)
+	}
+	sum := sha256.Sum256(data)
+	return FileHash(hex.EncodeToString(sum[:])), nil
+}
+
+// HashTestSet returns a stable hash of a set of test file paths, for use
+// as a cache key component. Order-independent: a sorted copy of paths is
+// hashed, so the same test set hashes the same whatever order it is
+// listed in.
+func HashTestSet(paths []string) TestSetHash {
+	sorted := append([]string(nil), paths...)
+	sort.Strings(sorted)
+	h := sha256.New()
+	for _, p := range sorted {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return TestSetHash(hex.EncodeToString(h.Sum(nil)))
+}
@@ -0,0 +1,32 @@
+// Package mutation implements fire-flow's mutation testing engine: it
+// generates mutants of Go source, runs the project's test suite against
+// each, and reports which mutants survived (indicating undertested code).
+package mutation
+
+// MutatorID names a single mutation operator, e.g. "comparison" or
+// "boolean-op".
+type MutatorID string
+
+// FileHash is a content hash (sha256, hex-encoded) of a source file at the
+// time a mutant was generated from it.
+type FileHash string
+
+// TestSetHash is a content hash of the set of test files/packages that
+// were exercised against a mutant.
+type TestSetHash string
+
+// Mutant describes a single generated mutation of a source file.
+type Mutant struct {
+	File    string    `json:"file"`
+	Mutator MutatorID `json:"mutator"`
+	Line    int       `json:"line"`
+	Col     int       `json:"col"`
+	Before  string    `json:"before"`
+	After   string    `json:"after"`
+}
+
+// Outcome records whether a mutant survived a test run.
+type Outcome struct {
+	Killed   bool   `json:"killed"`
+	KilledBy string `json:"killed_by,omitempty"`
+}
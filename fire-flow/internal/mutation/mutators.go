@@ -0,0 +1,153 @@
+package mutation
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+)
+
+// comparisonFlips maps a token to the mutant it should become. Only tokens present in
+// this table are mutated; everything else is left untouched.
+var comparisonFlips = map[token.Token]token.Token{
+	token.EQL: token.NEQ,
+	token.NEQ: token.EQL,
+	token.LSS: token.GEQ,
+	token.LEQ: token.GTR,
+	token.GTR: token.LEQ,
+	token.GEQ: token.LSS,
+}
+
+var booleanFlips = map[token.Token]token.Token{
+	token.LAND: token.LOR,
+	token.LOR:  token.LAND,
+}
+
+// operatorByName maps a token's string form back to the token itself, for
+// the small set of operators the mutators above ever emit. Built from the
+// same flip tables so it can never drift out of sync with them.
+var operatorByName = buildOperatorByName()
+
+func buildOperatorByName() map[string]token.Token {
+	m := make(map[string]token.Token)
+	for from, to := range comparisonFlips {
+		m[from.String()] = from
+		m[to.String()] = to
+	}
+	for from, to := range booleanFlips {
+		m[from.String()] = from
+		m[to.String()] = to
+	}
+	return m
+}
+
+// Generate parses the Go source at path and returns one Mutant per
+// mutable site found by the built-in AST mutators (comparison operators,
+// boolean operators, and boolean-literal returns). It replaces the
+// external go-mutesting binary: no subprocess, no missing-tool failures.
+func Generate(path string) ([]Mutant, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s for mutation: %w", path, err)
+	}
+
+	var mutants []Mutant
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.BinaryExpr:
+			if to, ok := comparisonFlips[node.Op]; ok {
+				mutants = append(mutants, siteMutant(fset, path, "comparison", node.Pos(), node.Op, to))
+			}
+			if to, ok := booleanFlips[node.Op]; ok {
+				mutants = append(mutants, siteMutant(fset, path, "boolean-op", node.Pos(), node.Op, to))
+			}
+		case *ast.ReturnStmt:
+			for _, result := range node.Results {
+				if ident, ok := result.(*ast.Ident); ok && (ident.Name == "true" || ident.Name == "false") {
+					flipped := "false"
+					if ident.Name == "false" {
+						flipped = "true"
+					}
+					pos := fset.Position(ident.Pos())
+					mutants = append(mutants, Mutant{
+						File:    path,
+						Mutator: "return-bool",
+						Line:    pos.Line,
+						Col:     pos.Column,
+						Before:  ident.Name,
+						After:   flipped,
+					})
+				}
+			}
+		}
+		return true
+	})
+	return mutants, nil
+}
+
+func siteMutant(fset *token.FileSet, path string, mutator MutatorID, pos token.Pos, from, to token.Token) Mutant {
+	p := fset.Position(pos)
+	return Mutant{
+		File:    path,
+		Mutator: mutator,
+		Line:    p.Line,
+		Col:     p.Column,
+		Before:  from.String(),
+		After:   to.String(),
+	}
+}
+
+// Apply produces the mutated source for a mutant by re-parsing the file
+// and rewriting the operator (or, for return-bool, the boolean literal)
+// at the mutant's recorded position, then
+// formatting the result. It never touches the file on disk; callers write
+// the returned bytes into an overlay (see Sandbox).
+func Apply(path string, m Mutant) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s for mutant application: %w", path, err)
+	}
+
+	applied := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if applied {
+			return false
+		}
+		if ident, ok := n.(*ast.Ident); ok && m.Mutator == "return-bool" {
+			pos := fset.Position(ident.Pos())
+			if pos.Line == m.Line && pos.Column == m.Col && ident.Name == m.Before {
+				ident.Name = m.After
+				applied = true
+				return false
+			}
+			return true
+		}
+		binExpr, ok := n.(*ast.BinaryExpr)
+		if !ok {
+			return true
+		}
+		pos := fset.Position(binExpr.Pos())
+		if pos.Line != m.Line || pos.Column != m.Col {
+			return true
+		}
+		if newOp, ok := operatorByName[m.After]; ok {
+			binExpr.Op = newOp
+			applied = true
+			return false
+		}
+		return true
+	})
+	if !applied {
+		return nil, fmt.Errorf("mutant site %s:%d:%d no longer matches source", m.File, m.Line, m.Col)
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, fmt.Errorf("formatting mutated %s: %w", path, err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package mutation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+)
+
+// Sandbox runs mutants inside their own overlay mounts so the real source
+// tree is never modified and mutants can run concurrently without each
+// needing a full temp-copy of the repo.
+type Sandbox struct {
+	Root       string // the real source tree (overlay lower layer)
+	ScratchDir string // parent directory for per-mutant upper/work/merged dirs
+	Mounter    overlay.Mounter
+}
+
+// NewSandbox builds a Sandbox over root, using mounter to create per-mutant
+// overlay sessions under scratchDir.
+func NewSandbox(root, scratchDir string, mounter overlay.Mounter) *Sandbox {
+	return &Sandbox{Root: root, ScratchDir: scratchDir, Mounter: mounter}
+}
+
+// Run mounts a fresh overlay for m, writes the mutated source into it, and
+// invokes runTests with the merged tree's root. The overlay is torn down
+// before Run returns, whether or not runTests succeeds.
+func (s *Sandbox) Run(m Mutant, runTests func(mergedRoot string) (Outcome, error)) (Outcome, error) {
+	id := fmt.Sprintf("%s-%d-%d-%s", filepath.Base(m.File), m.Line, m.Col, m.Mutator)
+	base := filepath.Join(s.ScratchDir, id)
+	cfg := overlay.MountConfig{
+		Lower:  s.Root,
+		Upper:  filepath.Join(base, "upper"),
+		Work:   filepath.Join(base, "work"),
+		Merged: filepath.Join(base, "merged"),
+	}
+
+	session, err := s.Mounter.Mount(cfg)
+	if err != nil {
+		return Outcome{}, fmt.Errorf("mounting sandbox for mutant %s: %w", id, err)
+	}
+	defer func() {
+		_ = session.Close()
+		_ = os.RemoveAll(base)
+	}()
+
+	mutated, err := Apply(m.File, m)
+	if err != nil {
+		return Outcome{}, fmt.Errorf("applying mutant %s: %w", id, err)
+	}
+	rel, err := filepath.Rel(s.Root, m.File)
+	if err != nil {
+		return Outcome{}, fmt.Errorf("resolving mutant file %s relative to sandbox root: %w", m.File, err)
+	}
+	if err := os.WriteFile(filepath.Join(cfg.Merged, rel), mutated, 0o644); err != nil {
+		return Outcome{}, fmt.Errorf("writing mutant %s into sandbox upper layer: %w", id, err)
+	}
+
+	return runTests(cfg.Merged)
+}
@@ -0,0 +1,46 @@
+package mutation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/ai"
+	"github.com/lprior-repo/fire-flow/internal/bead"
+)
+
+// Survivor pairs a surviving mutant with the diff that produced it and the
+// tests that were supposed to cover it, for handoff to AI-assisted triage.
+type Survivor struct {
+	Mutant        Mutant
+	Diff          string
+	CoveringTests []string
+}
+
+// Triage asks runner to propose a new test for each survivor and files the
+// proposal as a bead, closing the loop between mutation testing and the
+// work queue instead of leaving surviving mutants to be noticed by hand.
+func Triage(ctx context.Context, runner ai.Runner, beadsPath, idPrefix string, survivors []Survivor) ([]bead.Issue, error) {
+	proposals := make([]bead.Issue, 0, len(survivors))
+	for i, s := range survivors {
+		prompt := fmt.Sprintf(
+			"A mutation survived the test suite, meaning the mutated behavior went unnoticed.\n\n"+
+				"Mutator: %s\nLocation: %s:%d\nDiff:\n%s\n\nTests that exercise this code: %v\n\n"+
+				"Propose a new test case that would fail against this mutant.",
+			s.Mutant.Mutator, s.Mutant.File, s.Mutant.Line, s.Diff, s.CoveringTests,
+		)
+		proposal, err := runner.Run(ctx, prompt)
+		if err != nil {
+			return proposals, fmt.Errorf("triaging survivor %s:%d: %w", s.Mutant.File, s.Mutant.Line, err)
+		}
+		issue, err := bead.AppendProposal(
+			beadsPath, idPrefix,
+			fmt.Sprintf("Untested mutation at %s:%d", s.Mutant.File, s.Mutant.Line),
+			proposal, 2, i,
+		)
+		if err != nil {
+			return proposals, err
+		}
+		proposals = append(proposals, issue)
+	}
+	return proposals, nil
+}
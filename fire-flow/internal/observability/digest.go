@@ -0,0 +1,106 @@
+package observability
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/cycle"
+	"github.com/lprior-repo/fire-flow/internal/history"
+)
+
+// Digest summarizes autonomous activity over a window, for a daily
+// email/Slack report of what happened without a human watching.
+type Digest struct {
+	Since          time.Time
+	Committed      int
+	DocsOnly       int
+	Reverted       int
+	GateBlocked    int
+	InfraErrors    int
+	NeedsAttention []string // bead IDs that ended in an infra error or gate block
+}
+
+// BuildDigest reads historyPath's event log and summarizes every entry
+// since the cutoff.
+func BuildDigest(historyPath string, since time.Time) (Digest, error) {
+	page, err := history.Read(historyPath, history.Query{})
+	if err != nil {
+		return Digest{}, err
+	}
+	d := Digest{Since: since}
+	for _, entry := range page.Entries {
+		if entry.FinishedAt.Before(since) {
+			continue
+		}
+		switch entry.Decision {
+		case cycle.DecisionCommitted:
+			d.Committed++
+		case cycle.DecisionDocsOnly:
+			d.DocsOnly++
+		case cycle.DecisionReverted:
+			d.Reverted++
+		case cycle.DecisionGateBlocked:
+			d.GateBlocked++
+			d.NeedsAttention = append(d.NeedsAttention, entry.BeadID)
+		case cycle.DecisionInfraError:
+			d.InfraErrors++
+			d.NeedsAttention = append(d.NeedsAttention, entry.BeadID)
+		}
+	}
+	return d, nil
+}
+
+// Text renders the digest as a plain-text report, suitable for both
+// email bodies and Slack messages.
+func (d Digest) Text() string {
+	report := fmt.Sprintf("fire-flow activity since %s\n", d.Since.Format(time.RFC3339))
+	report += fmt.Sprintf("  committed: %d\n  docs-only: %d\n  reverted: %d\n  gate-blocked: %d\n  infra errors: %d\n",
+		d.Committed, d.DocsOnly, d.Reverted, d.GateBlocked, d.InfraErrors)
+	if len(d.NeedsAttention) > 0 {
+		report += fmt.Sprintf("  needs attention: %v\n", d.NeedsAttention)
+	}
+	return report
+}
+
+// SMTPConfig is where to send the digest email.
+type SMTPConfig struct {
+	Host string
+	Port int
+	From string
+	To   []string
+}
+
+// SendEmail sends the digest as a plain-text email via cfg's SMTP relay.
+// No authentication is applied here; a relay reachable without auth
+// (typical for internal SMTP relays on a private network) is assumed,
+// matching how fire-flow's other outbound integrations (git push, gh)
+// rely on ambient trust rather than embedding credentials.
+func SendEmail(cfg SMTPConfig, digest Digest) error {
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	msg := fmt.Sprintf("Subject: fire-flow daily digest\r\n\r\n%s", digest.Text())
+	if err := smtp.SendMail(addr, nil, cfg.From, cfg.To, []byte(msg)); err != nil {
+		return fmt.Errorf("sending digest email via %s: %w", addr, err)
+	}
+	return nil
+}
+
+// SendSlack posts the digest to a Slack incoming webhook.
+func SendSlack(webhookURL string, digest Digest) error {
+	body, err := json.Marshal(map[string]string{"text": digest.Text()})
+	if err != nil {
+		return fmt.Errorf("marshaling Slack payload: %w", err)
+	}
+	resp, err := http.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("posting digest to Slack: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("Slack webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
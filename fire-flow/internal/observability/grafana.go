@@ -0,0 +1,45 @@
+// Package observability exports fire-flow's cycle history as dashboards
+// and notifications for external tools (Grafana, desktop notifiers).
+package observability
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// grafanaDashboardTemplate is a minimal dashboard covering the metrics
+// fire-flow's history log can answer directly: commit/revert rate and
+// revert streak over time. {{DATASOURCE}} is substituted with the
+// datasource UID at export time.
+const grafanaDashboardTemplate = `{
+  "title": "fire-flow TCR cycles",
+  "panels": [
+    {
+      "title": "Commits vs Reverts",
+      "type": "timeseries",
+      "datasource": {"uid": "{{DATASOURCE}}"},
+      "targets": [
+        {"expr": "sum(rate(fire_flow_cycle_total{decision=\"committed\"}[5m]))", "legendFormat": "committed"},
+        {"expr": "sum(rate(fire_flow_cycle_total{decision=\"reverted\"}[5m]))", "legendFormat": "reverted"}
+      ]
+    },
+    {
+      "title": "Current Revert Streak",
+      "type": "stat",
+      "datasource": {"uid": "{{DATASOURCE}}"},
+      "targets": [{"expr": "fire_flow_revert_streak"}]
+    }
+  ]
+}
+`
+
+// ExportGrafanaDashboard writes the dashboard template to path with
+// datasourceUID substituted in.
+func ExportGrafanaDashboard(path, datasourceUID string) error {
+	rendered := strings.ReplaceAll(grafanaDashboardTemplate, "{{DATASOURCE}}", datasourceUID)
+	if err := os.WriteFile(path, []byte(rendered), 0o644); err != nil {
+		return fmt.Errorf("writing grafana dashboard to %s: %w", path, err)
+	}
+	return nil
+}
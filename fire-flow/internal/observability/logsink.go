@@ -0,0 +1,115 @@
+package observability
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// LogEntry is one structured log line shipped to Loki or Kestra, tagged
+// with the orchestration run that produced it so entries from run-ai and
+// test invocations land attached to the right execution.
+type LogEntry struct {
+	Time    time.Time         `json:"time"`
+	RunID   string            `json:"run_id"`
+	Level   string            `json:"level"`
+	Message string            `json:"message"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
+// LogSink batches entries and ships them on a timer or when the batch
+// fills, applying backpressure by blocking the caller when the internal
+// queue is full instead of buffering unboundedly.
+type LogSink struct {
+	client    *http.Client
+	endpoint  string
+	batchSize int
+	queue     chan LogEntry
+	flush     chan struct{}
+	done      chan struct{}
+}
+
+// NewLogSink starts a background shipper posting batches of entries as
+// newline-delimited JSON to endpoint (a Loki push endpoint or a Kestra
+// execution log URL). queueSize bounds in-flight entries; Send blocks
+// once it's full, which is the backpressure: a struggling downstream
+// slows fire-flow's own logging rather than growing memory without
+// bound.
+func NewLogSink(endpoint string, batchSize, queueSize int, flushInterval time.Duration) *LogSink {
+	s := &LogSink{
+		client:    &http.Client{Timeout: 10 * time.Second},
+		endpoint:  endpoint,
+		batchSize: batchSize,
+		queue:     make(chan LogEntry, queueSize),
+		flush:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	go s.run(flushInterval)
+	return s
+}
+
+// Send enqueues an entry, blocking if the queue is full.
+func (s *LogSink) Send(entry LogEntry) {
+	s.queue <- entry
+}
+
+// Close stops the shipper after flushing anything queued.
+func (s *LogSink) Close() {
+	close(s.queue)
+	<-s.done
+}
+
+func (s *LogSink) run(flushInterval time.Duration) {
+	defer close(s.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var batch []LogEntry
+	shipIfDue := func(force bool) {
+		if len(batch) == 0 {
+			return
+		}
+		if !force && len(batch) < s.batchSize {
+			return
+		}
+		if err := s.ship(batch); err != nil {
+			fmt.Println("fire-flow: log shipping failed:", err)
+		}
+		batch = nil
+	}
+
+	for {
+		select {
+		case entry, ok := <-s.queue:
+			if !ok {
+				shipIfDue(true)
+				return
+			}
+			batch = append(batch, entry)
+			shipIfDue(false)
+		case <-ticker.C:
+			shipIfDue(true)
+		}
+	}
+}
+
+func (s *LogSink) ship(batch []LogEntry) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, e := range batch {
+		if err := enc.Encode(e); err != nil {
+			return fmt.Errorf("encoding log entry: %w", err)
+		}
+	}
+	resp, err := s.client.Post(s.endpoint, "application/x-ndjson", &buf)
+	if err != nil {
+		return fmt.Errorf("posting %d log entries to %s: %w", len(batch), s.endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("shipping logs to %s returned status %d", s.endpoint, resp.StatusCode)
+	}
+	return nil
+}
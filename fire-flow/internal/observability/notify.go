@@ -0,0 +1,28 @@
+package observability
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// Notify shows a desktop notification for a RED/GREEN transition, using
+// notify-send on Linux and osascript on macOS. It's best-effort: a
+// missing notifier binary is reported but never treated as fatal, since a
+// cycle's outcome must never depend on the desktop environment being present.
+func Notify(title, message string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", message, title)
+		cmd = exec.Command("osascript", "-e", script)
+	case "linux":
+		cmd = exec.Command("notify-send", title, message)
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("sending desktop notification: %w", err)
+	}
+	return nil
+}
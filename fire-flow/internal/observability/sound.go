@@ -0,0 +1,28 @@
+package observability
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// PlaySound plays an audio file for TCR feedback, using afplay on macOS
+// and aplay on Linux. A no-op if path is empty (sound is opt-in).
+func PlaySound(path string) error {
+	if path == "" {
+		return nil
+	}
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("afplay", path)
+	case "linux":
+		cmd = exec.Command("aplay", path)
+	default:
+		return fmt.Errorf("sound playback is not supported on %s", runtime.GOOS)
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("playing sound %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package observability
+
+import "fmt"
+
+// Theme controls how fire-flow signals RED/GREEN feedback to a human at
+// the terminal: an ANSI color plus an optional sound to play.
+type Theme struct {
+	GreenColor string // ANSI escape, e.g. "\x1b[32m"
+	RedColor   string
+	ResetColor string
+	GreenSound string // path to an audio file, empty disables sound
+	RedSound   string
+}
+
+// DefaultTheme is fire-flow's out-of-the-box theme: standard ANSI
+// green/red, no sound (opt-in, since terminals vary wildly in audio bell
+// support).
+func DefaultTheme() Theme {
+	return Theme{
+		GreenColor: "\x1b[32m",
+		RedColor:   "\x1b[31m",
+		ResetColor: "\x1b[0m",
+	}
+}
+
+// Paint wraps text in the theme's color for green (ok=true) or red
+// (ok=false) feedback.
+func (t Theme) Paint(ok bool, text string) string {
+	color := t.RedColor
+	if ok {
+		color = t.GreenColor
+	}
+	return fmt.Sprintf("%s%s%s", color, text, t.ResetColor)
+}
+
+// Sound returns the sound file path for a given outcome, if the theme
+// configures one.
+func (t Theme) Sound(ok bool) string {
+	if ok {
+		return t.GreenSound
+	}
+	return t.RedSound
+}
@@ -0,0 +1,19 @@
+package offline
+
+import (
+	"net"
+	"time"
+)
+
+// IsOffline reports whether probeAddr (typically the git remote's host,
+// "host:443") can't be reached within timeout. It's a best-effort
+// signal, not a guarantee — a caller should still fall back to the
+// outbox on a push failure even if IsOffline said false.
+func IsOffline(probeAddr string, timeout time.Duration) bool {
+	conn, err := net.DialTimeout("tcp", probeAddr, timeout)
+	if err != nil {
+		return true
+	}
+	_ = conn.Close()
+	return false
+}
@@ -0,0 +1,136 @@
+// Package offline lets a cycle keep working when the network is down:
+// operations that must eventually reach a remote (git push, bead status
+// sync) are queued in a durable outbox instead of failing the cycle,
+// and replayed once connectivity returns.
+package offline
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Operation is one durable outbox entry, identified by Kind so Replay
+// can dispatch it to the right handler (e.g. "push", "bead_status").
+type Operation struct {
+	Kind       string          `json:"kind"`
+	Payload    json.RawMessage `json:"payload"`
+	EnqueuedAt time.Time       `json:"enqueued_at"`
+}
+
+// Outbox is an append-only JSONL file of pending operations, in the same
+// spirit as gitops.PushQueue but generalized to any operation kind.
+type Outbox struct {
+	Path string
+}
+
+// NewOutbox returns an Outbox backed by path.
+func NewOutbox(path string) *Outbox {
+	return &Outbox{Path: path}
+}
+
+// Enqueue durably records an operation for later replay.
+func (o *Outbox) Enqueue(kind string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshaling outbox payload for %s: %w", kind, err)
+	}
+	op := Operation{Kind: kind, Payload: data, EnqueuedAt: time.Now()}
+	line, err := json.Marshal(op)
+	if err != nil {
+		return fmt.Errorf("marshaling outbox operation: %w", err)
+	}
+	f, err := os.OpenFile(o.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening outbox %s: %w", o.Path, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing to outbox %s: %w", o.Path, err)
+	}
+	return nil
+}
+
+// Pending returns every queued operation without removing them, so a
+// caller can report the backlog size.
+func (o *Outbox) Pending() ([]Operation, error) {
+	f, err := os.Open(o.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading outbox %s: %w", o.Path, err)
+	}
+	defer f.Close()
+
+	var ops []Operation
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var op Operation
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return nil, fmt.Errorf("parsing outbox entry: %w", err)
+		}
+		ops = append(ops, op)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning outbox %s: %w", o.Path, err)
+	}
+	return ops, nil
+}
+
+// drain returns every queued operation and clears the outbox, so a
+// concurrent Enqueue during Replay can't be silently lost.
+func (o *Outbox) drain() ([]Operation, error) {
+	ops, err := o.Pending()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Remove(o.Path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("clearing outbox %s: %w", o.Path, err)
+	}
+	return ops, nil
+}
+
+// Handler processes one operation's payload for a given Kind.
+type Handler func(payload json.RawMessage) error
+
+// ReplayResult reports how many operations succeeded and re-queues
+// anything after the first failure.
+type ReplayResult struct {
+	Replayed int
+	Pending  int
+}
+
+// Replay drains the outbox and dispatches each operation to handlers by
+// Kind, stopping and re-queuing the remainder (including the failed
+// operation) on the first error, in the same order-preserving spirit as
+// gitops.Flush.
+func Replay(o *Outbox, handlers map[string]Handler) (ReplayResult, error) {
+	ops, err := o.drain()
+	if err != nil {
+		return ReplayResult{}, err
+	}
+	for i, op := range ops {
+		handler, ok := handlers[op.Kind]
+		if !ok {
+			return requeueFrom(o, ops, i, fmt.Errorf("no offline replay handler registered for kind %q", op.Kind))
+		}
+		if err := handler(op.Payload); err != nil {
+			return requeueFrom(o, ops, i, fmt.Errorf("replaying outbox operation %q: %w", op.Kind, err))
+		}
+	}
+	return ReplayResult{Replayed: len(ops)}, nil
+}
+
+func requeueFrom(o *Outbox, ops []Operation, from int, cause error) (ReplayResult, error) {
+	for _, op := range ops[from:] {
+		var payload any = op.Payload
+		if reErr := o.Enqueue(op.Kind, payload); reErr != nil {
+			return ReplayResult{Replayed: from}, fmt.Errorf("%w, and re-queuing remaining operations failed: %v", cause, reErr)
+		}
+	}
+	return ReplayResult{Replayed: from, Pending: len(ops) - from}, cause
+}
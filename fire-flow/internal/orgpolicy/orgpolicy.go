@@ -0,0 +1,96 @@
+// Package orgpolicy fetches an organization-level defaults repository
+// (shared prompts, policies, and config presets) and layers a project's
+// own config on top of it, so dozens of repos can share one canonical
+// automation policy instead of each drifting independently.
+package orgpolicy
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// Source points at an org defaults repository, pinned to a ref so a
+// policy update doesn't silently change behavior mid-run across every
+// repo that inherits from it until each repo re-syncs deliberately.
+type Source struct {
+	RepoURL string
+	Ref     string
+	// DestDir is where the org repo is cloned to locally, e.g.
+	// .opencode/tcr/org-defaults.
+	DestDir string
+}
+
+// Sync clones s.RepoURL into s.DestDir if it isn't already there, or
+// fetches and checks out s.Ref if it is, leaving DestDir at exactly the
+// pinned ref either way.
+func Sync(s Source) error {
+	if _, err := os.Stat(filepath.Join(s.DestDir, ".git")); err == nil {
+		if err := runGit(s.DestDir, "fetch", "--depth", "1", "origin", s.Ref); err != nil {
+			return err
+		}
+		return runGit(s.DestDir, "checkout", "FETCH_HEAD")
+	}
+	if err := os.MkdirAll(filepath.Dir(s.DestDir), 0o755); err != nil {
+		return fmt.Errorf("orgpolicy: creating parent of %s: %w", s.DestDir, err)
+	}
+	return runGit("", "clone", "--depth", "1", "--branch", s.Ref, s.RepoURL, s.DestDir)
+}
+
+// Layer merges the org defaults directory under project, returning the
+// per-relative-path source each layered config file ultimately came
+// from: "project" for a file the project overrides itself, "org" for
+// one only the org repo provides. Project files always win on
+// conflicting relative paths — inheritance is a floor, not a ceiling.
+func Layer(orgDir, projectDir string) (map[string]string, error) {
+	sources := map[string]string{}
+	if err := collect(orgDir, "org", sources); err != nil {
+		return nil, err
+	}
+	if err := collect(projectDir, "project", sources); err != nil {
+		return nil, err
+	}
+	return sources, nil
+}
+
+// Resolve returns the directory a given relative config path should be
+// read from: projectDir if the project provides it, orgDir otherwise.
+// It does not itself read or copy the file, leaving that to the
+// existing per-file config loaders (config.LoadGateConfig and friends),
+// which already handle a missing file gracefully.
+func Resolve(orgDir, projectDir, rel string) string {
+	if _, err := os.Stat(filepath.Join(projectDir, rel)); err == nil {
+		return filepath.Join(projectDir, rel)
+	}
+	return filepath.Join(orgDir, rel)
+}
+
+func collect(dir, label string, into map[string]string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		into[rel] = label
+		return nil
+	})
+}
+
+func runGit(dir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("orgpolicy: git %v: %w: %s", args, err, out)
+	}
+	return nil
+}
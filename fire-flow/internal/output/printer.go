@@ -0,0 +1,51 @@
+// Package output formats fire-flow's human-facing terminal output:
+// colorized where supported, but always locale-independent so a CI log
+// or a screen-shared terminal in another region reads identically.
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/lprior-repo/fire-flow/internal/i18n"
+)
+
+// Printer writes human-readable status lines. Color is disabled when
+// NO_COLOR is set (https://no-color.org) or the destination isn't a TTY.
+type Printer struct {
+	w         io.Writer
+	colorable bool
+	lang      string
+}
+
+// NewPrinter returns a Printer writing to w, honoring NO_COLOR and the
+// colorable flag (callers detect TTY-ness themselves, since that check
+// differs across platforms). The OK/FAIL labels are translated per
+// i18n.Language(language); pass "" to use FIRE_FLOW_LANG or English.
+func NewPrinter(w io.Writer, colorable bool, language string) *Printer {
+	if os.Getenv("NO_COLOR") != "" {
+		colorable = false
+	}
+	return &Printer{w: w, colorable: colorable, lang: i18n.Language(language)}
+}
+
+// Status prints an ok/fail line. Timestamps are always UTC in RFC3339,
+// never the host locale's format, so logs stay diffable across machines;
+// only the OK/FAIL label itself is translated.
+func (p *Printer) Status(ok bool, at time.Time, message string) {
+	prefix := i18n.Message(p.lang, i18n.KeyStatusFail)
+	if ok {
+		prefix = i18n.Message(p.lang, i18n.KeyStatusOK)
+	}
+	if p.colorable {
+		color := "\x1b[31m"
+		if ok {
+			color = "\x1b[32m"
+		}
+		fmt.Fprintf(p.w, "%s[%s]\x1b[0m %s %s\n", color, prefix, at.UTC().Format(time.RFC3339), message)
+		return
+	}
+	fmt.Fprintf(p.w, "[%s] %s %s\n", prefix, at.UTC().Format(time.RFC3339), message)
+}
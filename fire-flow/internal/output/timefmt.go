@@ -0,0 +1,61 @@
+package output
+
+import (
+	"fmt"
+	"time"
+)
+
+// DisplayConfig holds display settings for a human reader: the timezone
+// Human renders absolute timestamps in, and the language operator-facing
+// strings (see internal/i18n) are translated into. Printer does not read
+// it; Printer.Status timestamps are always UTC.
+type DisplayConfig struct {
+	Timezone string `yaml:"timezone"` // IANA name; empty means local
+	Language string `yaml:"language"` // e.g. "es"; empty defers to FIRE_FLOW_LANG then "en"
+}
+
+// Location resolves cfg's configured timezone, falling back to
+// time.Local when unset.
+func (cfg DisplayConfig) Location() (*time.Location, error) {
+	if cfg.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("loading display timezone %q: %w", cfg.Timezone, err)
+	}
+	return loc, nil
+}
+
+// relativeThreshold is how recent t must be, relative to now, for Human
+// to prefer a relative duration ("3m ago") over an absolute timestamp;
+// beyond it "3 days ago" is less useful than the exact time.
+const relativeThreshold = 24 * time.Hour
+
+// Human renders t for a terminal: a relative duration when t is within
+// relativeThreshold of now, otherwise an absolute RFC3339 timestamp in
+// cfg's display timezone. JSON and log output should keep using
+// t.UTC().Format(time.RFC3339) directly, as Status already does, so
+// machine-read timestamps stay locale-independent — Human is only for
+// what a person reads in a terminal.
+func Human(t, now time.Time, cfg DisplayConfig) (string, error) {
+	if age := now.Sub(t); age >= 0 && age < relativeThreshold {
+		return relativeDuration(age), nil
+	}
+	loc, err := cfg.Location()
+	if err != nil {
+		return "", err
+	}
+	return t.In(loc).Format(time.RFC3339), nil
+}
+
+func relativeDuration(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return fmt.Sprintf("%ds ago", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	default:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	}
+}
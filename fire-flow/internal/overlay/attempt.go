@@ -0,0 +1,94 @@
+package overlay
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AttemptSnapshot is a content fingerprint of every file in a session's
+// upper layer at one point in time, cheap enough to keep one per attempt
+// so consecutive attempts can be diffed without re-reading full file
+// contents.
+type AttemptSnapshot struct {
+	Hashes map[string]string // rel path -> sha256 hex
+}
+
+// Snapshot hashes every file currently in session's upper layer,
+// skipping anything matching ignorePatterns like Diff does.
+func Snapshot(session *Session, ignorePatterns []string) (AttemptSnapshot, error) {
+	upper := session.Config.Upper
+	snap := AttemptSnapshot{Hashes: map[string]string{}}
+	err := filepath.Walk(upper, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(upper, path)
+		if err != nil {
+			return fmt.Errorf("resolving %s relative to upper layer: %w", path, err)
+		}
+		if ignored(rel, ignorePatterns) {
+			return nil
+		}
+		sum, err := hashFile(path)
+		if err != nil {
+			return err
+		}
+		snap.Hashes[rel] = sum
+		return nil
+	})
+	if err != nil {
+		return AttemptSnapshot{}, fmt.Errorf("snapshotting overlay session: %w", err)
+	}
+	return snap, nil
+}
+
+// AttemptDelta is what changed between two AttemptSnapshots.
+type AttemptDelta struct {
+	Added    []string
+	Removed  []string
+	Modified []string
+}
+
+// DiffAttempts compares snapshots from attempt N-1 (prev) and attempt N
+// (curr), so a retry prompt or log line can say what actually changed
+// since the last try instead of repeating the full diff.
+func DiffAttempts(prev, curr AttemptSnapshot) AttemptDelta {
+	var delta AttemptDelta
+	for path, sum := range curr.Hashes {
+		prevSum, existed := prev.Hashes[path]
+		switch {
+		case !existed:
+			delta.Added = append(delta.Added, path)
+		case prevSum != sum:
+			delta.Modified = append(delta.Modified, path)
+		}
+	}
+	for path := range prev.Hashes {
+		if _, stillThere := curr.Hashes[path]; !stillThere {
+			delta.Removed = append(delta.Removed, path)
+		}
+	}
+	return delta
+}
+
+// Summary renders the delta as a short human/model-readable line list.
+func (d AttemptDelta) Summary() string {
+	if len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0 {
+		return "no changes since the previous attempt"
+	}
+	summary := ""
+	for _, f := range d.Added {
+		summary += fmt.Sprintf("+ %s\n", f)
+	}
+	for _, f := range d.Modified {
+		summary += fmt.Sprintf("~ %s\n", f)
+	}
+	for _, f := range d.Removed {
+		summary += fmt.Sprintf("- %s\n", f)
+	}
+	return summary
+}
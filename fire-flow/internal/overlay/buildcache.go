@@ -0,0 +1,38 @@
+package overlay
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SharedBuildCache is a host-level directory reused as GOCACHE across
+// every overlay session, so a fresh mount doesn't force a full recompile
+// just because its own workspace has never built before.
+type SharedBuildCache struct {
+	Dir string
+}
+
+// NewSharedBuildCache ensures dir exists with permissions safe for
+// multiple concurrent sessions to share (all sessions run as the same
+// user fire-flow runs as, so 0o755 is enough isolation from other
+// accounts on the host without blocking sibling sessions from writing).
+func NewSharedBuildCache(dir string) (*SharedBuildCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating shared build cache dir %s: %w", dir, err)
+	}
+	return &SharedBuildCache{Dir: dir}, nil
+}
+
+// EnvFor returns the GOCACHE environment variable to set on any command
+// (test runner, go build, mutation sandbox) run inside session's merged
+// workspace, keyed by session so unrelated projects don't share objects
+// built against different module graphs.
+func (c *SharedBuildCache) EnvFor(session *Session) (string, error) {
+	key := filepath.Base(session.Config.Merged)
+	dir := filepath.Join(c.Dir, key)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("creating build cache subdir %s: %w", dir, err)
+	}
+	return "GOCACHE=" + dir, nil
+}
@@ -0,0 +1,93 @@
+package overlay
+
+import (
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Faults configures which failure a ChaosMounter should inject next,
+// letting integration tests exercise the TCR engine's recovery paths
+// (retry-on-EBUSY, abort-on-ENOSPC, etc.) without waiting for those
+// conditions to occur naturally.
+type Faults struct {
+	MountDelay           time.Duration
+	FailMountWithENOSPC  bool
+	FailUnmountWithEBUSY bool
+	// FailCommitPartial simulates a partial copy: callers that check it
+	// (see CommitOptionsFor) set CommitOptions.FailAfter so Commit stops
+	// after the first file and returns an error instead of completing
+	// the walk.
+	FailCommitPartial bool
+}
+
+// CommitOptionsFor applies faults' commit-related settings onto opts,
+// for callers wiring a ChaosMounter's armed faults into a real Commit
+// call.
+func (f Faults) CommitOptionsFor(opts CommitOptions) CommitOptions {
+	if f.FailCommitPartial {
+		opts.FailAfter = 1
+	}
+	return opts
+}
+
+// ChaosMounter wraps a real Mounter and injects configured faults, one
+// use each, so a test can arrange "the next unmount fails with EBUSY"
+// without the injected fault leaking into unrelated assertions later in
+// the same test.
+type ChaosMounter struct {
+	Inner Mounter
+
+	mu     sync.Mutex
+	faults Faults
+}
+
+// NewChaosMounter wraps inner with no faults configured; use Inject to
+// arm one before the operation under test.
+func NewChaosMounter(inner Mounter) *ChaosMounter {
+	return &ChaosMounter{Inner: inner}
+}
+
+// Inject arms faults for the next matching operations. It replaces any
+// previously armed faults rather than merging, so each test scenario
+// starts from a clean slate.
+func (c *ChaosMounter) Inject(faults Faults) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.faults = faults
+}
+
+func (c *ChaosMounter) take() Faults {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f := c.faults
+	c.faults = Faults{}
+	return f
+}
+
+func (c *ChaosMounter) Mount(cfg MountConfig) (*Session, error) {
+	f := c.take()
+	if f.MountDelay > 0 {
+		time.Sleep(f.MountDelay)
+	}
+	if f.FailMountWithENOSPC {
+		return nil, fmt.Errorf("mounting %s: %w", cfg.Merged, syscall.ENOSPC)
+	}
+	session, err := c.Inner.Mount(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if f.FailUnmountWithEBUSY {
+		realUnmount := session.unmount
+		unmounted := false
+		session.unmount = func() error {
+			if !unmounted {
+				unmounted = true
+				return fmt.Errorf("unmounting %s: %w", cfg.Merged, syscall.EBUSY)
+			}
+			return realUnmount()
+		}
+	}
+	return session, nil
+}
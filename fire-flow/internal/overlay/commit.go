@@ -0,0 +1,169 @@
+package overlay
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CommitOptions controls how a session's upper layer is copied down into
+// its lower dir.
+type CommitOptions struct {
+	// Verify hashes each committed file in both layers after copying and
+	// returns an error listing any mismatches, so a silent copy failure
+	// never reaches git.
+	Verify bool
+
+	// MaxFileBytes skips (with a warning via OnSkip) any file larger than
+	// this size instead of copying it; 0 means no limit.
+	MaxFileBytes int64
+
+	// OnSkip, if set, is called for each file skipped due to MaxFileBytes.
+	OnSkip func(rel string, size int64)
+
+	// OnProgress, if set, is called after each file is committed, with
+	// the running count and the number of files in the upper layer counted
+	// before the walk (ignored and skipped files included).
+	OnProgress func(done, total int)
+
+	// IgnorePatterns are glob patterns (matched against the path relative
+	// to the upper layer, filepath.Match syntax) for build artifacts
+	// (bin/, coverage.out, node_modules/...) that AI runs generate but
+	// that should never merge down into the lower dir.
+	IgnorePatterns []string
+
+	// AllowPatterns overrides IgnorePatterns for paths that also match
+	// it, e.g. so a deliberate `go mod vendor` run can still commit
+	// vendor/ even though it's excluded from diff reports and watch
+	// triggers by default.
+	AllowPatterns []string
+
+	// FailAfter aborts the commit after copying this many files,
+	// returning an error, instead of completing the walk. Used by
+	// ChaosMounter's FailCommitPartial (see chaos.go) to give recovery
+	// paths real coverage against a partial-copy failure; 0 means no
+	// injected failure.
+	FailAfter int
+}
+
+// ignored reports whether rel matches any of patterns, checking both the
+// full relative path and each path segment so a pattern like
+// "node_modules" matches "node_modules/foo/bar.js" too.
+func ignored(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		for _, part := range strings.Split(rel, string(filepath.Separator)) {
+			if ok, _ := filepath.Match(pattern, part); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Commit copies every file in the session's upper layer into the lower
+// dir, returning the list of committed relative paths. Copies are
+// sparse-aware on Linux (see copySparse) so large mostly-empty files
+// don't cost disk or time proportional to their logical size.
+func Commit(session *Session, opts CommitOptions) ([]string, error) {
+	upper := session.Config.Upper
+	lower := session.Config.Lower
+
+	total := 0
+	_ = filepath.Walk(upper, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			total++
+		}
+		return nil
+	})
+
+	var committed []string
+	done := 0
+	err := filepath.Walk(upper, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(upper, path)
+		if err != nil {
+			return fmt.Errorf("resolving %s relative to upper layer: %w", path, err)
+		}
+		if ignored(rel, opts.IgnorePatterns) && !ignored(rel, opts.AllowPatterns) {
+			return nil
+		}
+		if opts.MaxFileBytes > 0 && info.Size() > opts.MaxFileBytes {
+			if opts.OnSkip != nil {
+				opts.OnSkip(rel, info.Size())
+			}
+			return nil
+		}
+		dst := filepath.Join(lower, rel)
+		if err := copySparse(path, dst, info.Mode()); err != nil {
+			return fmt.Errorf("committing %s: %w", rel, err)
+		}
+		committed = append(committed, rel)
+		done++
+		if opts.OnProgress != nil {
+			opts.OnProgress(done, total)
+		}
+		if opts.FailAfter > 0 && done >= opts.FailAfter {
+			return fmt.Errorf("injected partial-copy failure after %d file(s)", done)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Verify {
+		if err := verifyCommit(upper, lower, committed); err != nil {
+			return committed, err
+		}
+	}
+	return committed, nil
+}
+
+// verifyCommit hashes each committed file in both the upper and lower
+// layers and reports any mismatch, catching a truncated or otherwise
+// corrupted copy before the caller unmounts the session.
+func verifyCommit(upper, lower string, relPaths []string) error {
+	var mismatches []string
+	for _, rel := range relPaths {
+		upperHash, err := hashFile(filepath.Join(upper, rel))
+		if err != nil {
+			return fmt.Errorf("hashing upper copy of %s: %w", rel, err)
+		}
+		lowerHash, err := hashFile(filepath.Join(lower, rel))
+		if err != nil {
+			return fmt.Errorf("hashing lower copy of %s: %w", rel, err)
+		}
+		if upperHash != lowerHash {
+			mismatches = append(mismatches, rel)
+		}
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("commit verification failed for %d file(s): %v", len(mismatches), mismatches)
+	}
+	return nil
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,37 @@
+package overlay
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Diff lists files changed in the session's upper layer relative to the
+// lower dir, skipping anything matching ignorePatterns (the same list
+// Commit takes as CommitOptions.IgnorePatterns) so build artifacts never show up as
+// pending changes.
+func Diff(session *Session, ignorePatterns []string) ([]string, error) {
+	upper := session.Config.Upper
+	var changed []string
+	err := filepath.Walk(upper, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(upper, path)
+		if err != nil {
+			return fmt.Errorf("resolving %s relative to upper layer: %w", path, err)
+		}
+		if ignored(rel, ignorePatterns) {
+			return nil
+		}
+		changed = append(changed, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("diffing overlay session: %w", err)
+	}
+	return changed, nil
+}
@@ -0,0 +1,62 @@
+package overlay
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FakeMounter satisfies Mounter by copying the lower directory into the
+// merged path instead of invoking the kernel's overlay driver. It is
+// slower and uses real disk space proportional to Lower's size, but works
+// anywhere a Mounter is needed without root/CAP_SYS_ADMIN.
+type FakeMounter struct{}
+
+// NewFakeMounter returns a Mounter backed by plain directory copies.
+func NewFakeMounter() Mounter { return FakeMounter{} }
+
+func (FakeMounter) Mount(cfg MountConfig) (*Session, error) {
+	if err := copyTree(cfg.Lower, cfg.Merged); err != nil {
+		return nil, fmt.Errorf("faking overlay mount at %s: %w", cfg.Merged, err)
+	}
+	return &Session{
+		Config:  cfg,
+		unmount: func() error { return os.RemoveAll(cfg.Merged) },
+	}, nil
+}
+
+func copyTree(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return fmt.Errorf("computing relative path for %s: %w", path, err)
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, in)
+	return err
+}
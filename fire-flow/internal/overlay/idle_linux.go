@@ -0,0 +1,96 @@
+//go:build linux
+
+package overlay
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// IdleReaper watches a set of overlay sessions for inactivity via inotify
+// and tears down (unmounts + removes) any session whose merged dir has
+// seen no filesystem activity for longer than MaxIdle, instead of
+// leaving them to accumulate like an un-run tmpwatch.
+type IdleReaper struct {
+	MaxIdle      time.Duration
+	fd           int
+	watches      map[int]*Session // inotify watch descriptor -> session
+	lastActivity map[int]time.Time
+}
+
+// NewIdleReaper opens an inotify instance for the reaper to watch
+// sessions on.
+func NewIdleReaper(maxIdle time.Duration) (*IdleReaper, error) {
+	fd, err := unix.InotifyInit1(unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("initializing inotify: %w", err)
+	}
+	return &IdleReaper{
+		MaxIdle:      maxIdle,
+		fd:           fd,
+		watches:      make(map[int]*Session),
+		lastActivity: make(map[int]time.Time),
+	}, nil
+}
+
+// Watch registers a session's merged dir for activity tracking.
+func (r *IdleReaper) Watch(session *Session) error {
+	wd, err := unix.InotifyAddWatch(r.fd, session.Config.Merged, unix.IN_MODIFY|unix.IN_CREATE|unix.IN_ATTRIB)
+	if err != nil {
+		return fmt.Errorf("watching %s: %w", session.Config.Merged, err)
+	}
+	r.watches[wd] = session
+	r.lastActivity[wd] = time.Now()
+	return nil
+}
+
+// Poll drains pending inotify events (refreshing activity timestamps) and
+// returns any sessions that have exceeded MaxIdle since their last
+// activity, closing and unregistering them.
+func (r *IdleReaper) Poll() ([]*Session, error) {
+	buf := make([]byte, 4096)
+	for {
+		n, err := unix.Read(r.fd, buf)
+		if err != nil {
+			break // EAGAIN: no more pending events, non-blocking fd
+		}
+		r.consumeEvents(buf[:n])
+	}
+
+	var reaped []*Session
+	now := time.Now()
+	for wd, last := range r.lastActivity {
+		if now.Sub(last) < r.MaxIdle {
+			continue
+		}
+		session := r.watches[wd]
+		if err := session.Close(); err != nil {
+			return reaped, fmt.Errorf("reaping idle session %s: %w", session.Config.Merged, err)
+		}
+		_ = os.RemoveAll(session.Config.Upper)
+		delete(r.watches, wd)
+		delete(r.lastActivity, wd)
+		reaped = append(reaped, session)
+	}
+	return reaped, nil
+}
+
+func (r *IdleReaper) consumeEvents(buf []byte) {
+	offset := 0
+	for offset+unix.SizeofInotifyEvent <= len(buf) {
+		raw := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+		if _, ok := r.watches[int(raw.Wd)]; ok {
+			r.lastActivity[int(raw.Wd)] = time.Now()
+		}
+		offset += unix.SizeofInotifyEvent + int(raw.Len)
+	}
+}
+
+// Close releases the reaper's inotify file descriptor.
+func (r *IdleReaper) Close() error {
+	return unix.Close(r.fd)
+}
@@ -0,0 +1,99 @@
+package overlay
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// lfsLargeFileThreshold flags binaries this size or larger when they
+// aren't covered by an LFS pattern, since a bare blob that big in the
+// overlay's upper layer usually means the AI added something that
+// should have gone through LFS instead.
+const lfsLargeFileThreshold = 5 * 1024 * 1024
+
+// LFSPatterns reads a repo's .gitattributes and returns the path
+// patterns configured to go through the LFS filter.
+func LFSPatterns(repoRoot string) ([]string, error) {
+	f, err := os.Open(filepath.Join(repoRoot, ".gitattributes"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading .gitattributes: %w", err)
+	}
+	defer f.Close()
+
+	var patterns []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.Contains(line, "filter=lfs") {
+			fields := strings.Fields(line)
+			if len(fields) > 0 {
+				patterns = append(patterns, fields[0])
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning .gitattributes: %w", err)
+	}
+	return patterns, nil
+}
+
+// LFSWarning describes a file in the overlay's upper layer that looks
+// like it should be tracked by LFS but isn't.
+type LFSWarning struct {
+	Path string
+	Size int64
+}
+
+// CheckLFS walks an overlay session's upper layer and warns about large
+// files not covered by any of repoRoot's LFS patterns, so a commit
+// doesn't silently bloat the repo with a binary LFS was meant to catch.
+func CheckLFS(session *Session, repoRoot string) ([]LFSWarning, error) {
+	patterns, err := LFSPatterns(repoRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []LFSWarning
+	err = filepath.Walk(session.Config.Upper, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if info.Size() < lfsLargeFileThreshold {
+			return nil
+		}
+		rel, err := filepath.Rel(session.Config.Upper, path)
+		if err != nil {
+			return err
+		}
+		if matchesAny(rel, patterns) {
+			return nil
+		}
+		warnings = append(warnings, LFSWarning{Path: rel, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scanning overlay upper layer for LFS candidates: %w", err)
+	}
+	return warnings, nil
+}
+
+func matchesAny(rel string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, rel); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(p, filepath.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+//go:build linux
+
+package overlay
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// LinuxMounter mounts real overlayfs sessions via the mount(2) syscall.
+// It requires CAP_SYS_ADMIN (or an unprivileged user namespace with
+// overlay support); use FakeMounter where that isn't available.
+type LinuxMounter struct{}
+
+// NewMounter returns the platform's real overlay Mounter.
+func NewMounter() Mounter { return LinuxMounter{} }
+
+func (LinuxMounter) Mount(cfg MountConfig) (*Session, error) {
+	for _, dir := range []string{cfg.Upper, cfg.Work, cfg.Merged} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("preparing overlay dir %s: %w", dir, err)
+		}
+	}
+	opts := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", cfg.Lower, cfg.Upper, cfg.Work)
+	if err := syscall.Mount("overlay", cfg.Merged, "overlay", 0, opts); err != nil {
+		return nil, &MountError{Reason: classifyMountErr(err, cfg), Merged: cfg.Merged, Err: err}
+	}
+	return &Session{
+		Config:  cfg,
+		unmount: func() error { return syscall.Unmount(cfg.Merged, 0) },
+	}, nil
+}
+
+// MountReadOnly mounts cfg.Lower directly onto cfg.Merged as an
+// overlayfs with no upperdir/workdir, so the kernel itself enforces
+// read-only access rather than relying on caller discipline.
+func (LinuxMounter) MountReadOnly(cfg MountConfig) (*Session, error) {
+	if err := os.MkdirAll(cfg.Merged, 0o755); err != nil {
+		return nil, fmt.Errorf("preparing overlay dir %s: %w", cfg.Merged, err)
+	}
+	opts := fmt.Sprintf("lowerdir=%s", cfg.Lower)
+	if err := syscall.Mount("overlay", cfg.Merged, "overlay", syscall.MS_RDONLY, opts); err != nil {
+		return nil, &MountError{Reason: classifyMountErr(err, cfg), Merged: cfg.Merged, Err: err}
+	}
+	return &Session{
+		Config:  cfg,
+		unmount: func() error { return syscall.Unmount(cfg.Merged, 0) },
+	}, nil
+}
@@ -0,0 +1,56 @@
+package overlay
+
+import "fmt"
+
+// MountReason classifies why an overlay mount failed, so callers (and
+// the doctor command) can show specific remediation instead of a raw
+// kernel errno.
+type MountReason string
+
+const (
+	ReasonPermissionDenied  MountReason = "permission_denied"
+	ReasonNoDevice          MountReason = "no_device"
+	ReasonNestedUnsupported MountReason = "nested_overlay_unsupported"
+	ReasonUpperOnNFS        MountReason = "upper_on_nfs"
+	ReasonPathTooLong       MountReason = "path_too_long"
+	ReasonNoSpace           MountReason = "no_space"
+	ReasonMaxStackDepth     MountReason = "max_stacking_depth"
+	ReasonUnknown           MountReason = "unknown"
+)
+
+// remediation maps each reason to operator-facing advice.
+var remediation = map[MountReason]string{
+	ReasonPermissionDenied:  "run as a user with CAP_SYS_ADMIN, or enable unprivileged user namespaces (kernel.unprivileged_userns_clone=1)",
+	ReasonNoDevice:          "load the overlay kernel module: `modprobe overlay`",
+	ReasonNestedUnsupported: "this lower dir is itself an overlayfs mount, which this kernel can't stack on; use a plain directory as the lower layer or upgrade the kernel (overlay-on-overlay landed in 5.11+)",
+	ReasonUpperOnNFS:        "overlayfs's upperdir must be on a local filesystem; move the upper/work dirs off the NFS mount",
+	ReasonPathTooLong:       "shorten the session's base directory; the overlay mount option string is capped by the kernel's page size",
+	ReasonNoSpace:           "free space on the filesystem backing the upper/work dirs, or point them at a larger volume",
+	ReasonMaxStackDepth:     "overlayfs allows a bounded stacking depth; flatten the lower layer instead of overlaying an overlay of an overlay",
+	ReasonUnknown:           "check `dmesg` for the kernel's overlayfs error",
+}
+
+// MountError wraps a failed mount with a classified Reason so callers
+// don't have to pattern-match on errno themselves.
+type MountError struct {
+	Reason MountReason
+	Merged string
+	Err    error
+}
+
+func (e *MountError) Error() string {
+	return fmt.Sprintf("mounting overlay at %s: %s: %v", e.Merged, e.Reason, e.Err)
+}
+
+func (e *MountError) Unwrap() error { return e.Err }
+
+// UserFriendlyError renders e for a human: the reason in plain words
+// plus the specific remediation to try next, the message `fire-flow
+// doctor` and CLI error output show instead of the raw errno.
+func (e *MountError) UserFriendlyError() string {
+	advice, ok := remediation[e.Reason]
+	if !ok {
+		advice = remediation[ReasonUnknown]
+	}
+	return fmt.Sprintf("overlay mount failed (%s): %s", e.Reason, advice)
+}
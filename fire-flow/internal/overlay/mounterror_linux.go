@@ -0,0 +1,58 @@
+//go:build linux
+
+package overlay
+
+import (
+	"errors"
+	"syscall"
+)
+
+// Filesystem type magic numbers from linux/magic.h, used to distinguish
+// EINVAL causes classifyMountErr can't tell apart from errno alone.
+const (
+	overlayFSMagic = 0x794c7630
+	nfsMagic       = 0x6969
+)
+
+// classifyMountErr maps a mount(2) failure to a MountReason. Nested
+// overlay unsupported, an NFS upper, and hitting the max stacking depth
+// all surface as plain EINVAL on Linux, so those three are told apart by
+// inspecting cfg's directories rather than errno alone.
+func classifyMountErr(err error, cfg MountConfig) MountReason {
+	var errno syscall.Errno
+	if !errors.As(err, &errno) {
+		return ReasonUnknown
+	}
+	switch errno {
+	case syscall.EPERM, syscall.EACCES:
+		return ReasonPermissionDenied
+	case syscall.ENODEV:
+		return ReasonNoDevice
+	case syscall.ENOSPC:
+		return ReasonNoSpace
+	case syscall.ENAMETOOLONG:
+		return ReasonPathTooLong
+	case syscall.EINVAL:
+		if hasFSType(cfg.Lower, overlayFSMagic) {
+			return ReasonNestedUnsupported
+		}
+		if hasFSType(cfg.Upper, nfsMagic) {
+			return ReasonUpperOnNFS
+		}
+		return ReasonMaxStackDepth
+	default:
+		return ReasonUnknown
+	}
+}
+
+// hasFSType reports whether the filesystem backing path has the given
+// statfs magic number, returning false (rather than erroring) when the
+// stat itself fails, since that just means classification falls back to
+// the next candidate reason.
+func hasFSType(path string, magic int64) bool {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return false
+	}
+	return int64(stat.Type) == magic
+}
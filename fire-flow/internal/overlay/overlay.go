@@ -0,0 +1,46 @@
+// Package overlay provides isolated, writable views of a source tree
+// backed by overlay filesystem mounts, so fire-flow subsystems (mutation
+// testing, AI edit sessions) can write changes without ever touching the
+// real working tree.
+package overlay
+
+// MountConfig describes a single overlay mount: a read-only lower layer
+// (the real source tree, or another overlay's merged view), a writable
+// upper layer where changes land, a scratch work directory required by
+// the kernel's overlay driver, and the merged path callers operate on.
+type MountConfig struct {
+	Lower  string
+	Upper  string
+	Work   string
+	Merged string
+
+	// Labels annotates the mount for operators (bead ID, session ID,
+	// purpose, ...), persisted in the mount registry and surfaced by
+	// status/ps/gc so a human can tell which overlay belongs to which
+	// workflow at a glance, without inferring it from the merged path.
+	Labels map[string]string
+}
+
+// Session is a live overlay mount. Callers read and write inside
+// Config.Merged and call Close when done to tear the mount down.
+type Session struct {
+	Config  MountConfig
+	unmount func() error
+}
+
+// Close tears down the mount. It is safe to call multiple times.
+func (s *Session) Close() error {
+	if s == nil || s.unmount == nil {
+		return nil
+	}
+	unmount := s.unmount
+	s.unmount = nil
+	return unmount()
+}
+
+// Mounter creates overlay sessions. It is an interface so callers can
+// substitute a FakeMounter in environments without overlay support (CI
+// containers lacking CAP_SYS_ADMIN, non-Linux hosts, unit tests).
+type Mounter interface {
+	Mount(cfg MountConfig) (*Session, error)
+}
@@ -0,0 +1,110 @@
+package overlay
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Pool keeps a warm set of pre-mounted overlay sessions so beads don't
+// each pay mount/unmount latency. Sessions are reset (upper layer
+// discarded and remounted) between assignments and retired once they
+// exceed MaxAge.
+type Pool struct {
+	mounter Mounter
+	config  MountConfig // template; Upper/Work/Merged get a per-slot suffix
+	size    int
+	maxAge  time.Duration
+
+	mu       sync.Mutex
+	sessions []*pooledSession
+}
+
+type pooledSession struct {
+	session *Session
+	slot    int
+	bornAt  time.Time
+}
+
+// NewPool creates a pool of up to size warm sessions built from template,
+// where template.Upper/Work/Merged are treated as base directories that
+// get a numeric slot suffix.
+func NewPool(mounter Mounter, template MountConfig, size int, maxAge time.Duration) *Pool {
+	return &Pool{mounter: mounter, config: template, size: size, maxAge: maxAge}
+}
+
+// Acquire returns a warm session, mounting a new one while the pool has
+// spare capacity, or resetting and returning the oldest session
+// otherwise. The pool does not track whether an earlier caller is still
+// using that session.
+func (p *Pool) Acquire() (*Session, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.sessions) < p.size {
+		slot := len(p.sessions)
+		ps, err := p.mountSlot(slot)
+		if err != nil {
+			return nil, err
+		}
+		p.sessions = append(p.sessions, ps)
+		return ps.session, nil
+	}
+
+	oldest := p.sessions[0]
+	for _, ps := range p.sessions[1:] {
+		if ps.bornAt.Before(oldest.bornAt) {
+			oldest = ps
+		}
+	}
+	if err := p.reset(oldest); err != nil {
+		return nil, err
+	}
+	return oldest.session, nil
+}
+
+// Sweep retires and re-mounts any session older than MaxAge, without
+// waiting for it to be acquired first. Call periodically from a
+// background loop.
+func (p *Pool) Sweep() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ps := range p.sessions {
+		if p.maxAge > 0 && time.Since(ps.bornAt) > p.maxAge {
+			if err := p.reset(ps); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Pool) reset(ps *pooledSession) error {
+	if err := ps.session.Close(); err != nil {
+		return fmt.Errorf("closing pooled session %d for reset: %w", ps.slot, err)
+	}
+	if err := os.RemoveAll(ps.session.Config.Upper); err != nil {
+		return fmt.Errorf("clearing upper layer for slot %d: %w", ps.slot, err)
+	}
+	fresh, err := p.mountSlot(ps.slot)
+	if err != nil {
+		return err
+	}
+	*ps = *fresh
+	return nil
+}
+
+func (p *Pool) mountSlot(slot int) (*pooledSession, error) {
+	cfg := MountConfig{
+		Lower:  p.config.Lower,
+		Upper:  fmt.Sprintf("%s.%d", p.config.Upper, slot),
+		Work:   fmt.Sprintf("%s.%d", p.config.Work, slot),
+		Merged: fmt.Sprintf("%s.%d", p.config.Merged, slot),
+	}
+	session, err := p.mounter.Mount(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("mounting pool slot %d: %w", slot, err)
+	}
+	return &pooledSession{session: session, slot: slot, bornAt: time.Now()}, nil
+}
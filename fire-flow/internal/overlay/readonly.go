@@ -0,0 +1,33 @@
+package overlay
+
+import "fmt"
+
+// ReadOnlyMounter mounts cfg without a writable upper layer, for
+// "inspection" sessions (a reviewer or tool browsing the candidate
+// merged view) that must never be able to modify the source tree.
+// Implementations that support a real overlay mount (LinuxMounter) mount
+// the lower layer directly with no upperdir/workdir at all, rather than
+// mounting read-write and merely refusing writes at a higher layer.
+type ReadOnlyMounter interface {
+	MountReadOnly(cfg MountConfig) (*Session, error)
+}
+
+// Inspect opens a read-only session for cfg.Lower if m supports it,
+// otherwise falls back to a normal, writable mount; nothing then stops
+// the caller writing to it. FakeMounter has no filesystem-level enforcement, so this
+// fallback exists to keep `fire-flow inspect` usable in environments
+// without overlay support, at the cost of relying on caller discipline.
+func Inspect(m Mounter, cfg MountConfig) (*Session, error) {
+	if ro, ok := m.(ReadOnlyMounter); ok {
+		session, err := ro.MountReadOnly(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("mounting %s read-only: %w", cfg.Lower, err)
+		}
+		return session, nil
+	}
+	session, err := m.Mount(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("mounting %s for inspection (no read-only support in %T): %w", cfg.Lower, m, err)
+	}
+	return session, nil
+}
@@ -0,0 +1,102 @@
+package overlay
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// RefreshResult reports what changed in the lower dir since a session
+// started, and which of those changes overlap with files already
+// modified in the upper layer.
+type RefreshResult struct {
+	LowerCommitsPulled int
+	Overlapping        []string
+}
+
+// Refresh fetches and fast-forwards the lower dir to its upstream branch,
+// then reports any files the session's upper layer has also touched, so
+// long-lived sessions don't silently work against a base that has since
+// moved. It never merges automatically: overlapping files are surfaced
+// for the caller (or the AI runner) to reconcile.
+func Refresh(lowerDir string, upperChangedFiles []string) (RefreshResult, error) {
+	before, err := headSHA(lowerDir)
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("reading lower dir HEAD: %w", err)
+	}
+	if err := gitRun(lowerDir, "pull", "--ff-only"); err != nil {
+		return RefreshResult{}, fmt.Errorf("pulling lower dir: %w", err)
+	}
+	after, err := headSHA(lowerDir)
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("reading lower dir HEAD: %w", err)
+	}
+	if before == after {
+		return RefreshResult{}, nil
+	}
+
+	touched, err := changedFiles(lowerDir, before, after)
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("diffing lower dir %s..%s: %w", before, after, err)
+	}
+	count, err := commitCount(lowerDir, before, after)
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("counting pulled commits: %w", err)
+	}
+
+	touchedSet := make(map[string]bool, len(touched))
+	for _, f := range touched {
+		touchedSet[f] = true
+	}
+	var overlapping []string
+	for _, f := range upperChangedFiles {
+		if touchedSet[f] {
+			overlapping = append(overlapping, f)
+		}
+	}
+	return RefreshResult{LowerCommitsPulled: count, Overlapping: overlapping}, nil
+}
+
+func headSHA(dir string) (string, error) {
+	return output(dir, "rev-parse", "HEAD")
+}
+
+func commitCount(dir, before, after string) (int, error) {
+	out, err := output(dir, "rev-list", "--count", before+".."+after)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	_, err = fmt.Sscanf(out, "%d", &n)
+	return n, err
+}
+
+func changedFiles(dir, before, after string) ([]string, error) {
+	out, err := output(dir, "diff", "--name-only", before, after)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+func gitRun(dir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func output(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
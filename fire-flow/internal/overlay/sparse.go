@@ -0,0 +1,11 @@
+//go:build !linux
+
+package overlay
+
+import "os"
+
+// copySparse falls back to a plain copy on platforms without
+// SEEK_DATA/SEEK_HOLE support.
+func copySparse(src, dst string, mode os.FileMode) error {
+	return copyFile(src, dst, mode)
+}
@@ -0,0 +1,68 @@
+//go:build linux
+
+package overlay
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// copySparse copies src to dst, skipping holes (regions with no backing
+// data) using SEEK_DATA/SEEK_HOLE, so a large sparse file costs time and
+// disk proportional to its actual data, not its logical size.
+func copySparse(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	size, err := in.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if _, err := in.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := out.Truncate(size); err != nil {
+		return fmt.Errorf("truncating %s to %d: %w", dst, size, err)
+	}
+
+	var offset int64
+	for offset < size {
+		dataStart, err := unix.Seek(int(in.Fd()), offset, unix.SEEK_DATA)
+		if err != nil {
+			// No more data regions (ENXIO) means the rest is a hole;
+			// the destination is already zero-filled by Truncate.
+			break
+		}
+		holeStart, err := unix.Seek(int(in.Fd()), dataStart, unix.SEEK_HOLE)
+		if err != nil {
+			holeStart = size
+		}
+		if _, err := in.Seek(dataStart, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := out.Seek(dataStart, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := io.CopyN(out, in, holeStart-dataStart); err != nil && err != io.EOF {
+			return fmt.Errorf("copying data region [%d,%d) of %s: %w", dataStart, holeStart, src, err)
+		}
+		offset = holeStart
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package overlay
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// processAliveSignal is signal 0: sending it performs the kernel's
+// existence/permission checks without actually delivering a signal.
+const processAliveSignal = syscall.Signal(0)
+
+// mountinfoMaxLineBytes covers even mount lines with long lowerdir=
+// option lists (overlay mounts can have many layers), well past
+// bufio.Scanner's 64KiB default max token size.
+const mountinfoMaxLineBytes = 1 << 20
+
+// MountRecord describes a live overlay session, so it can be reported on
+// later without keeping the mount's process alive. OwnerPID is the process that
+// created the mount; cleanup only ever acts on records whose owner is
+// confirmed dead, never on a path match alone, since two sessions can
+// share a "fire-flow" substring in their merged dir names.
+type MountRecord struct {
+	BeadID   string
+	Merged   string
+	OwnerPID int
+	Labels   map[string]string
+}
+
+// NewMountRecord builds a MountRecord from a live session, carrying its
+// MountConfig.Labels through to the registry.
+func NewMountRecord(session *Session, beadID string, ownerPID int) MountRecord {
+	return MountRecord{BeadID: beadID, Merged: session.Config.Merged, OwnerPID: ownerPID, Labels: session.Config.Labels}
+}
+
+// OwnerAlive reports whether record's owning process is still running,
+// by sending it signal 0 (no-op, just existence/permission check).
+func OwnerAlive(record MountRecord) bool {
+	if record.OwnerPID <= 0 {
+		return false
+	}
+	proc, err := os.FindProcess(record.OwnerPID)
+	if err != nil {
+		return false
+	}
+	// On Unix, FindProcess always succeeds; Signal(0) is the actual
+	// liveness check.
+	return proc.Signal(processAliveSignal) == nil
+}
+
+// StillMounted reports whether record.Merged is actually mounted right
+// now, by scanning /proc/self/mountinfo, rather than trusting the
+// recorded state blindly (a host reboot or manual `umount` leaves stale
+// records behind otherwise).
+func StillMounted(record MountRecord) (bool, error) {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), mountinfoMaxLineBytes)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		// mountinfo field 5 (0-indexed 4) is the mount point.
+		if len(fields) > 4 && decodeMountinfoEscapes(fields[4]) == record.Merged {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// decodeMountinfoEscapes decodes the octal escapes (\040 for space,
+// \011 for tab, \012 for newline, \134 for backslash) the kernel uses
+// for special characters in mountinfo paths, so a mount point containing
+// a space doesn't fail to match its recorded path.
+func decodeMountinfoEscapes(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) {
+			if code, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(code))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// RefreshRecords checks every recorded mount and returns only those still
+// actually mounted, so a status report never lists a session as live
+// when the kernel disagrees.
+func RefreshRecords(records []MountRecord) ([]MountRecord, error) {
+	var fresh []MountRecord
+	for _, r := range records {
+		ok, err := StillMounted(r)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			fresh = append(fresh, r)
+		}
+	}
+	return fresh, nil
+}
+
+// StaleRecords returns records whose owning process has died, i.e. safe
+// to unmount and remove. It never flags a record solely because its path
+// looks like a fire-flow mount; ownership is the only signal.
+func StaleRecords(records []MountRecord) []MountRecord {
+	var stale []MountRecord
+	for _, r := range records {
+		if !OwnerAlive(r) {
+			stale = append(stale, r)
+		}
+	}
+	return stale
+}
@@ -0,0 +1,89 @@
+package overlay
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Submodule is one entry from a repo's .gitmodules.
+type Submodule struct {
+	Path string
+	URL  string
+}
+
+// Submodules parses repoRoot's .gitmodules so mount composition can
+// include each submodule's checkout in the lower layer instead of
+// leaving it as an empty directory.
+func Submodules(repoRoot string) ([]Submodule, error) {
+	f, err := os.Open(filepath.Join(repoRoot, ".gitmodules"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading .gitmodules: %w", err)
+	}
+	defer f.Close()
+
+	var subs []Submodule
+	var cur *Submodule
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "[submodule"):
+			if cur != nil {
+				subs = append(subs, *cur)
+			}
+			cur = &Submodule{}
+		case strings.HasPrefix(line, "path =") && cur != nil:
+			cur.Path = strings.TrimSpace(strings.TrimPrefix(line, "path ="))
+		case strings.HasPrefix(line, "url =") && cur != nil:
+			cur.URL = strings.TrimSpace(strings.TrimPrefix(line, "url ="))
+		}
+	}
+	if cur != nil {
+		subs = append(subs, *cur)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning .gitmodules: %w", err)
+	}
+	return subs, nil
+}
+
+// MountWithSubmodules is Mount, but first ensures every submodule under
+// cfg.Lower is checked out (`git submodule update --init`) so the lower
+// layer the AI sees isn't missing files a build depends on.
+func MountWithSubmodules(m Mounter, cfg MountConfig) (*Session, error) {
+	subs, err := Submodules(cfg.Lower)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) > 0 {
+		cmd := exec.Command("git", "submodule", "update", "--init", "--recursive")
+		cmd.Dir = cfg.Lower
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return nil, fmt.Errorf("initializing submodules in %s: %w: %s", cfg.Lower, err, strings.TrimSpace(string(out)))
+		}
+	}
+	return m.Mount(cfg)
+}
+
+// SubmoduleChanges reports which changed files (as returned by Diff)
+// fall inside a submodule path, so callers can decide whether to block
+// the commit or forward the change into the submodule's own repo.
+func SubmoduleChanges(changed []string, subs []Submodule) []string {
+	var inSubmodule []string
+	for _, rel := range changed {
+		for _, s := range subs {
+			if rel == s.Path || strings.HasPrefix(rel, s.Path+string(filepath.Separator)) {
+				inSubmodule = append(inSubmodule, rel)
+				break
+			}
+		}
+	}
+	return inSubmodule
+}
@@ -0,0 +1,46 @@
+package overlay
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// unshareEnvVar marks a re-exec'd child so it doesn't try to unshare
+// again, avoiding infinite recursion.
+const unshareEnvVar = "FIRE_FLOW_UNSHARED"
+
+// RunInUserNamespace re-execs the current test binary inside a new user
+// and mount namespace (unshare(CLONE_NEWUSER|CLONE_NEWNS)), where
+// overlay mounts are permitted without root on modern kernels. Tests for
+// LinuxMounter can call this to get real mount coverage in CI instead of
+// skipping whenever the runner lacks CAP_SYS_ADMIN.
+//
+// It must be called from TestMain, before m.Run, not from inside an
+// individual test: on success it calls os.Exit(0) in the parent, and
+// Go's testing package panics on an os.Exit(0) that happens during
+// m.Run (see internal/testlog.PanicOnExit0) to catch exactly this kind
+// of premature-exit bug.
+//
+// If already inside a re-exec'd child (unshareEnvVar set), RunInUserNamespace
+// returns immediately so TestMain's call to m.Run runs the real tests.
+func RunInUserNamespace() error {
+	if os.Getenv(unshareEnvVar) != "" {
+		return nil
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("resolving test binary path: %w", err)
+	}
+	cmd := exec.Command("unshare", "--user", "--mount", "--map-root-user", self)
+	cmd.Args = append(cmd.Args, os.Args[1:]...)
+	cmd.Env = append(os.Environ(), unshareEnvVar+"=1")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("re-execing under unshare: %w", err)
+	}
+	os.Exit(0)
+	return nil
+}
@@ -0,0 +1,167 @@
+// Package pipeline runs a cycle's enforcement stages (gate, lint, test,
+// mutation, policy, commit, push) as a configurable ordered list instead
+// of a hardcoded sequence, so a team can reorder, disable, time-box, or
+// soften individual stages without forking fire-flow.
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// StageName identifies one of the stages fire-flow's cycle runs through.
+type StageName string
+
+const (
+	StageGate     StageName = "gate"
+	StageLint     StageName = "lint"
+	StageTest     StageName = "test"
+	StageMutation StageName = "mutation"
+	StagePolicy   StageName = "policy"
+	StageCommit   StageName = "commit"
+	StagePush     StageName = "push"
+)
+
+// Stage configures one entry in the pipeline's stage list.
+type Stage struct {
+	Name StageName
+	// Enabled controls whether the stage runs at all; a disabled stage
+	// is skipped, not treated as passing.
+	Enabled bool
+	// Timeout bounds the stage's run; zero means no timeout beyond the
+	// caller's own context.
+	Timeout time.Duration
+	// AllowFailure records the stage's outcome but continues the
+	// pipeline instead of stopping it, e.g. for a mutation-testing
+	// stage a team wants visibility into without blocking on yet.
+	AllowFailure bool
+	// SkipUnlessChanged, when non-empty, skips this stage unless at
+	// least one changed file (from the overlay diff) matches one of
+	// these patterns — a bare directory name matches any file beneath
+	// it, and filepath.Match glob syntax (e.g. "*.go") matches a whole
+	// path or any of its segments. Empty means always run when Enabled.
+	SkipUnlessChanged []string
+}
+
+// matchesChanged reports whether s should run given changed, the set of
+// changed files from the overlay diff.
+func (s Stage) matchesChanged(changed []string) bool {
+	if len(s.SkipUnlessChanged) == 0 {
+		return true
+	}
+	for _, rel := range changed {
+		if pathMatchesAny(rel, s.SkipUnlessChanged) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathMatchesAny reports whether rel matches any of patterns, treating a
+// pattern with no glob metacharacters as a directory prefix (so
+// "internal/overlay" matches "internal/overlay/mount.go") and any other
+// pattern as a filepath.Match glob checked against both the full path
+// and each path segment (so "*.go" matches by extension anywhere).
+func pathMatchesAny(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if !strings.ContainsAny(pattern, "*?[") {
+			if rel == pattern || strings.HasPrefix(rel, pattern+"/") {
+				return true
+			}
+			continue
+		}
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		for _, part := range strings.Split(rel, string(filepath.Separator)) {
+			if ok, _ := filepath.Match(pattern, part); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DefaultStages is fire-flow's built-in order — the sequence this repo
+// ran before it became configurable — with every stage enabled, no
+// timeout, and no stage allowed to fail.
+func DefaultStages() []Stage {
+	names := []StageName{StageGate, StageLint, StageTest, StageMutation, StagePolicy, StageCommit, StagePush}
+	stages := make([]Stage, len(names))
+	for i, name := range names {
+		stages[i] = Stage{Name: name, Enabled: true}
+	}
+	return stages
+}
+
+// StepFunc performs one stage's work.
+type StepFunc func(ctx context.Context) error
+
+// Pipeline pairs an ordered stage list with the step implementations to
+// run for each named stage.
+type Pipeline struct {
+	Stages []Stage
+	Steps  map[StageName]StepFunc
+	// ChangedFiles is the overlay diff's changed-file list, used to
+	// evaluate each stage's SkipUnlessChanged condition. Nil disables
+	// every such condition (no files ever match), so a caller that
+	// doesn't wire in a diff still gets a correctly-run pipeline as long
+	// as no stage declares SkipUnlessChanged.
+	ChangedFiles []string
+}
+
+// StageResult records what happened when a stage ran (or didn't).
+type StageResult struct {
+	Name    StageName
+	Skipped bool // stage was disabled, had no registered step, or its skip condition didn't match
+	// SkipReason explains why Skipped is true, e.g. "disabled" or
+	// "no changed files matched skip_unless_changed"; empty when
+	// Skipped is false.
+	SkipReason string
+	Err        error
+	Allowed    bool // Err is non-nil but the stage's AllowFailure absorbed it
+	Duration   time.Duration
+}
+
+// Run executes each enabled stage in order, stopping at the first
+// failure whose stage doesn't allow it. It always returns the results
+// gathered so far, even when it returns early on a hard failure.
+func (p Pipeline) Run(ctx context.Context) ([]StageResult, error) {
+	results := make([]StageResult, 0, len(p.Stages))
+	for _, stage := range p.Stages {
+		if !stage.Enabled {
+			results = append(results, StageResult{Name: stage.Name, Skipped: true, SkipReason: "disabled"})
+			continue
+		}
+		if !stage.matchesChanged(p.ChangedFiles) {
+			results = append(results, StageResult{Name: stage.Name, Skipped: true, SkipReason: "no changed files matched skip_unless_changed"})
+			continue
+		}
+		step, ok := p.Steps[stage.Name]
+		if !ok {
+			results = append(results, StageResult{Name: stage.Name, Skipped: true, SkipReason: "no step registered"})
+			continue
+		}
+
+		runCtx := ctx
+		cancel := func() {}
+		if stage.Timeout > 0 {
+			runCtx, cancel = context.WithTimeout(ctx, stage.Timeout)
+		}
+		start := time.Now()
+		err := step(runCtx)
+		cancel()
+		result := StageResult{Name: stage.Name, Err: err, Duration: time.Since(start)}
+		if err != nil && stage.AllowFailure {
+			result.Allowed = true
+		}
+		results = append(results, result)
+		if err != nil && !stage.AllowFailure {
+			return results, fmt.Errorf("stage %s: %w", stage.Name, err)
+		}
+	}
+	return results, nil
+}
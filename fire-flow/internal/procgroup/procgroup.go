@@ -0,0 +1,18 @@
+// Package procgroup runs a subprocess in its own process group so
+// killing it on timeout or cancellation also kills every child it
+// spawned, instead of leaving orphans running after the parent exits.
+package procgroup
+
+import "os/exec"
+
+// Setup configures cmd (before Start) to run in a new process group of
+// its own.
+func Setup(cmd *exec.Cmd) {
+	setup(cmd)
+}
+
+// Kill terminates cmd's entire process group. Call only after Start has
+// returned successfully.
+func Kill(cmd *exec.Cmd) error {
+	return kill(cmd)
+}
@@ -0,0 +1,25 @@
+//go:build linux || darwin
+
+package procgroup
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+func setup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// kill sends SIGKILL to the whole process group by signaling the
+// negative of the process's PID, the same convention shells use for
+// job control.
+func kill(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
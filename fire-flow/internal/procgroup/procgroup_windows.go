@@ -0,0 +1,17 @@
+//go:build !linux && !darwin
+
+package procgroup
+
+import "os/exec"
+
+// setup is a no-op: Windows has no POSIX process-group equivalent
+// exposed the same way, and Kill below falls back to killing just the
+// direct child.
+func setup(cmd *exec.Cmd) {}
+
+func kill(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	return cmd.Process.Kill()
+}
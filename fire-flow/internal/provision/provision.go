@@ -0,0 +1,132 @@
+// Package provision sets up a fresh worker host to run fire-flow
+// quickly: a blobless/shallow clone instead of a full history fetch, a
+// sparse-checkout scoped to the module being worked, and the
+// .opencode/tcr layout the AI runner and TCR gate expect.
+package provision
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/lprior-repo/fire-flow/internal/baseline"
+)
+
+// Options configures a worker provisioning run.
+type Options struct {
+	RepoURL    string
+	DestDir    string
+	Module     string // sparse-checkout scope, relative to repo root; empty means no sparse-checkout
+	Depth      int    // 0 means use blobless (--filter=blob:none) instead of a fixed depth
+	MainBranch string
+	// BaselineTestCommand, if set, is run in DestDir right after
+	// provisioning (e.g. []string{"go", "test", "-json", "./..."}) with
+	// its output recorded as the RED baseline, so the gate can later
+	// tell a pre-existing failure apart from one this attempt
+	// introduced. Left unset, no baseline is recorded.
+	BaselineTestCommand []string
+}
+
+// Result reports what Provision actually did, for status output.
+type Result struct {
+	DestDir          string
+	Blobless         bool
+	Sparse           bool
+	BaselineRecorded bool
+}
+
+// Provision clones opts.RepoURL into opts.DestDir and lays out the
+// .opencode/tcr directories fire-flow's state store and AI runner expect.
+func Provision(opts Options) (Result, error) {
+	if opts.MainBranch == "" {
+		opts.MainBranch = "main"
+	}
+	result := Result{DestDir: opts.DestDir}
+
+	cloneArgs := []string{"clone", "--branch", opts.MainBranch}
+	if opts.Depth > 0 {
+		cloneArgs = append(cloneArgs, "--depth", fmt.Sprintf("%d", opts.Depth))
+	} else {
+		cloneArgs = append(cloneArgs, "--filter=blob:none")
+		result.Blobless = true
+	}
+	if opts.Module != "" {
+		cloneArgs = append(cloneArgs, "--sparse")
+		result.Sparse = true
+	}
+	cloneArgs = append(cloneArgs, opts.RepoURL, opts.DestDir)
+
+	if err := run("", cloneArgs...); err != nil {
+		return result, fmt.Errorf("cloning %s: %w", opts.RepoURL, err)
+	}
+
+	if opts.Module != "" {
+		if err := run(opts.DestDir, "sparse-checkout", "set", opts.Module); err != nil {
+			return result, fmt.Errorf("setting sparse-checkout to %s: %w", opts.Module, err)
+		}
+	}
+
+	if err := layoutOpencode(opts.DestDir); err != nil {
+		return result, err
+	}
+
+	if len(opts.BaselineTestCommand) > 0 {
+		if err := recordBaseline(opts.DestDir, opts.BaselineTestCommand); err != nil {
+			return result, err
+		}
+		result.BaselineRecorded = true
+	}
+	return result, nil
+}
+
+// recordBaseline runs testCommand in destDir and saves its failing
+// tests as the RED baseline. A non-zero exit from testCommand is
+// expected (some tests are meant to fail) and isn't itself an error;
+// only a failure to capture or parse the output is.
+func recordBaseline(destDir string, testCommand []string) error {
+	cmd := exec.Command(testCommand[0], testCommand[1:]...)
+	cmd.Dir = destDir
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("piping baseline test output: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting baseline test command: %w", err)
+	}
+	b, buildErr := baseline.Record(context.Background(), stdout)
+	_ = cmd.Wait()
+	if buildErr != nil {
+		return fmt.Errorf("recording baseline: %w", buildErr)
+	}
+	return baseline.Save(filepath.Join(destDir, ".opencode", "tcr"), b)
+}
+
+// layoutOpencode creates the .opencode/tcr directories fire-flow's state
+// store (internal/state) and history log (internal/history) read and
+// write, so a freshly provisioned host doesn't hit "no such file or
+// directory" on its first cycle.
+func layoutOpencode(destDir string) error {
+	dirs := []string{
+		filepath.Join(destDir, ".opencode", "tcr"),
+		filepath.Join(destDir, ".opencode", "tcr", "backups"),
+		filepath.Join(destDir, ".opencode", "tcr", "scratch"),
+	}
+	for _, d := range dirs {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			return fmt.Errorf("creating %s: %w", d, err)
+		}
+	}
+	return nil
+}
+
+func run(dir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
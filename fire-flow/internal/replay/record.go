@@ -0,0 +1,64 @@
+// Package replay records a cycle's deterministic inputs so a later
+// `fire-flow replay` can re-execute the gate decision against recorded
+// data — no live repo, test run, or AI call required — to debug why a
+// past cycle resolved the way it did.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lprior-repo/fire-flow/internal/gate"
+)
+
+// Record captures everything a cycle's gate decision depended on, so
+// replaying it later reproduces the same decision path.
+type Record struct {
+	CycleID string `json:"cycle_id"`
+	// GateContext is the exact gate.Context passed to gate.Decide.
+	GateContext gate.Context `json:"gate_context"`
+	// GateConfig is the raw gate-config.yaml content, re-parsed at
+	// replay time rather than stored pre-parsed, so replay always goes
+	// through the same config.LoadGateConfig path a live cycle does.
+	GateConfig []byte `json:"gate_config"`
+	// Prompts are the AI prompts issued during the cycle, kept for
+	// context even though replay doesn't re-invoke the AI.
+	Prompts []string `json:"prompts"`
+	// TestOutput is the raw test runner output the cycle observed.
+	TestOutput string `json:"test_output"`
+}
+
+// path returns the on-disk location for id's record under dir.
+func path(dir, id string) string {
+	return filepath.Join(dir, id+".json")
+}
+
+// Save writes r to dir, creating it as needed.
+func Save(dir string, r Record) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating replay dir %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling replay record for %s: %w", r.CycleID, err)
+	}
+	if err := os.WriteFile(path(dir, r.CycleID), data, 0o644); err != nil {
+		return fmt.Errorf("writing replay record to %s: %w", path(dir, r.CycleID), err)
+	}
+	return nil
+}
+
+// Load reads back a previously Saved record.
+func Load(dir, id string) (Record, error) {
+	data, err := os.ReadFile(path(dir, id))
+	if err != nil {
+		return Record{}, fmt.Errorf("reading replay record %s: %w", id, err)
+	}
+	var r Record
+	if err := json.Unmarshal(data, &r); err != nil {
+		return Record{}, fmt.Errorf("parsing replay record %s: %w", id, err)
+	}
+	return r, nil
+}
@@ -0,0 +1,43 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/config"
+	"github.com/lprior-repo/fire-flow/internal/gate"
+)
+
+// Result is the outcome of re-running a recorded cycle's gate decision.
+type Result struct {
+	Verdict gate.Verdict `json:"verdict"`
+}
+
+// Run re-executes the deterministic part of a cycle — parsing the
+// recorded gate config and running gate.Decide against the recorded
+// context — without a live repo, a test run, or an AI call. It's the
+// engine behind `fire-flow replay`.
+func Run(ctx context.Context, r Record) (Result, error) {
+	var cfg config.GateConfig
+	if len(r.GateConfig) > 0 {
+		data, _, err := config.ApplyAliases(r.GateConfig)
+		if err != nil {
+			return Result{}, fmt.Errorf("parsing recorded gate config for %s: %w", r.CycleID, err)
+		}
+		cfg, err = config.ParseGateConfig(data)
+		if err != nil {
+			return Result{}, fmt.Errorf("parsing recorded gate config for %s: %w", r.CycleID, err)
+		}
+	}
+
+	plugins, err := cfg.PluginChecks()
+	if err != nil {
+		return Result{}, fmt.Errorf("building plugin checks for %s: %w", r.CycleID, err)
+	}
+
+	verdict, err := gate.Decide(ctx, cfg.Rules, plugins, cfg.DocPatterns, r.GateContext)
+	if err != nil {
+		return Result{}, fmt.Errorf("replaying gate decision for %s: %w", r.CycleID, err)
+	}
+	return Result{Verdict: verdict}, nil
+}
@@ -0,0 +1,138 @@
+//go:build linux
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Cgroup wraps a cgroup v2 leaf under /sys/fs/cgroup that fire-flow
+// creates per bead attempt to enforce Limits on its subprocess tree.
+type Cgroup struct {
+	path string
+}
+
+// NewCgroup creates a cgroup v2 leaf named beadID under parent (typically
+// "/sys/fs/cgroup/fire-flow") and applies limits to it.
+func NewCgroup(parent, beadID string, limits Limits) (*Cgroup, error) {
+	path := filepath.Join(parent, beadID)
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, fmt.Errorf("creating cgroup %s: %w", path, err)
+	}
+	c := &Cgroup{path: path}
+	if limits.CPUQuota > 0 {
+		// cpu.max is "<quota> <period>" in microseconds.
+		period := 100000
+		quota := int(limits.CPUQuota * float64(period))
+		if err := c.write("cpu.max", fmt.Sprintf("%d %d", quota, period)); err != nil {
+			return nil, err
+		}
+	}
+	if limits.MemoryBytes > 0 {
+		if err := c.write("memory.max", strconv.FormatInt(limits.MemoryBytes, 10)); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *Cgroup) write(file, value string) error {
+	if err := os.WriteFile(filepath.Join(c.path, file), []byte(value), 0o644); err != nil {
+		return fmt.Errorf("setting %s on cgroup %s: %w", file, c.path, err)
+	}
+	return nil
+}
+
+// AddProcess moves pid into the cgroup, so its resource usage (and that
+// of any child it spawns) counts against Limits. Callers that manage
+// Start/Wait themselves instead of going through Run must call this
+// right after Start, before the process has a chance to do meaningful
+// work unconstrained.
+func (c *Cgroup) AddProcess(pid int) error {
+	return c.write("cgroup.procs", strconv.Itoa(pid))
+}
+
+// CheckHit reports which limit was hit by whatever ran inside the
+// cgroup, for callers using AddProcess directly rather than Run.
+func (c *Cgroup) CheckHit() Hit {
+	return c.checkOOM()
+}
+
+// Run executes cmd inside the cgroup, enforcing wallClock as a hard
+// timeout, and reports which limit (if any) caused termination.
+func (c *Cgroup) Run(ctx context.Context, cmd *exec.Cmd, wallClock time.Duration) (Hit, error) {
+	if wallClock > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, wallClock)
+		defer cancel()
+	}
+
+	if err := cmd.Start(); err != nil {
+		return Hit{}, fmt.Errorf("starting bead subprocess: %w", err)
+	}
+	if err := c.AddProcess(cmd.Process.Pid); err != nil {
+		_ = cmd.Process.Kill()
+		return Hit{}, err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err := <-done:
+		hit := c.checkOOM()
+		return hit, err
+	case <-ctx.Done():
+		_ = cmd.Process.Kill()
+		<-done
+		return Hit{WallClock: true}, ctx.Err()
+	}
+}
+
+// checkOOM reports whether the kernel's OOM killer fired inside this
+// cgroup during the run.
+func (c *Cgroup) checkOOM() Hit {
+	data, err := os.ReadFile(filepath.Join(c.path, "memory.events"))
+	if err != nil {
+		return Hit{}
+	}
+	return Hit{Memory: containsNonZeroOOMKill(string(data))}
+}
+
+func containsNonZeroOOMKill(events string) bool {
+	const key = "oom_kill "
+	idx := indexOf(events, key)
+	if idx == -1 {
+		return false
+	}
+	rest := events[idx+len(key):]
+	end := indexOf(rest, "\n")
+	if end != -1 {
+		rest = rest[:end]
+	}
+	return rest != "0"
+}
+
+func indexOf(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			return i
+		}
+	}
+	return -1
+}
+
+// Close removes the cgroup. It must be called after the subprocess has
+// exited, or the kernel will refuse removal.
+func (c *Cgroup) Close() error {
+	if err := os.Remove(c.path); err != nil {
+		return fmt.Errorf("removing cgroup %s: %w", c.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+//go:build !linux
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"time"
+)
+
+// errUnsupported is returned by every Cgroup operation on non-Linux
+// hosts: cgroup v2 is a Linux kernel feature with no equivalent here,
+// the same reasoning internal/capability's non-Linux stubs use.
+var errUnsupported = errors.New("cgroup resource limits are only supported on linux")
+
+// Cgroup is a no-op placeholder on non-Linux hosts.
+type Cgroup struct{}
+
+// NewCgroup always fails on non-Linux hosts; callers should treat this
+// as "limits unavailable" and either skip enforcement or refuse to run
+// unbounded, per their own risk tolerance.
+func NewCgroup(parent, beadID string, limits Limits) (*Cgroup, error) {
+	return nil, errUnsupported
+}
+
+func (c *Cgroup) AddProcess(pid int) error { return errUnsupported }
+
+func (c *Cgroup) Run(ctx context.Context, cmd *exec.Cmd, wallClock time.Duration) (Hit, error) {
+	return Hit{}, errUnsupported
+}
+
+func (c *Cgroup) CheckHit() Hit { return Hit{} }
+
+func (c *Cgroup) Close() error { return errUnsupported }
@@ -0,0 +1,26 @@
+// Package resource bounds how much CPU, memory, and wall-clock time a
+// bead's AI and test subprocesses may consume, so one runaway bead can't
+// starve the host running the rest of the queue.
+package resource
+
+import "time"
+
+// Limits configures the ceilings applied to a single bead's subprocess
+// tree.
+type Limits struct {
+	CPUQuota    float64       // fraction of one CPU core, e.g. 2.0 = two cores
+	MemoryBytes int64         // 0 means unlimited
+	WallClock   time.Duration // 0 means unlimited
+}
+
+// Hit records which limit, if any, caused a subprocess to be killed.
+type Hit struct {
+	CPU       bool
+	Memory    bool
+	WallClock bool
+}
+
+// Any reports whether any limit was hit.
+func (h Hit) Any() bool {
+	return h.CPU || h.Memory || h.WallClock
+}
@@ -0,0 +1,108 @@
+// Package review serves a local web UI for approving or rejecting a
+// pending overlay session's changes before they're committed, so a
+// human reviewer doesn't need to push a branch and open a PR just to
+// look at one bead's diff.
+package review
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+)
+
+// Decision is what a reviewer chose for a pending session.
+type Decision string
+
+const (
+	DecisionPending  Decision = "pending"
+	DecisionApproved Decision = "approved"
+	DecisionRejected Decision = "rejected"
+)
+
+// Pending is one overlay session awaiting review.
+type Pending struct {
+	BeadID     string
+	Session    *overlay.Session
+	Diff       []string
+	TestOutput string
+	Decision   Decision
+}
+
+// Server serves the review UI and records approve/reject decisions,
+// which the TCR loop polls (see Decision) before committing.
+type Server struct {
+	pending map[string]*Pending
+	tmpl    *template.Template
+}
+
+// NewServer returns a review Server with no sessions queued yet.
+func NewServer() (*Server, error) {
+	tmpl, err := template.New("review").Parse(pageTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing review page template: %w", err)
+	}
+	return &Server{pending: make(map[string]*Pending), tmpl: tmpl}, nil
+}
+
+// Enqueue adds a session for review.
+func (s *Server) Enqueue(p *Pending) {
+	p.Decision = DecisionPending
+	s.pending[p.BeadID] = p
+}
+
+// Decision returns the current decision for a bead, or DecisionPending
+// if it hasn't been reviewed yet (or isn't queued at all).
+func (s *Server) Decision(beadID string) Decision {
+	p, ok := s.pending[beadID]
+	if !ok {
+		return DecisionPending
+	}
+	return p.Decision
+}
+
+// Handler builds the review UI's HTTP handler: a listing page and
+// approve/reject actions.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handleIndex)
+	mux.HandleFunc("/approve", s.handleDecide(DecisionApproved))
+	mux.HandleFunc("/reject", s.handleDecide(DecisionRejected))
+	return mux
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if err := s.tmpl.Execute(w, s.pending); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) handleDecide(decision Decision) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		beadID := r.URL.Query().Get("bead")
+		p, ok := s.pending[beadID]
+		if !ok {
+			http.Error(w, "unknown bead", http.StatusNotFound)
+			return
+		}
+		p.Decision = decision
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+	}
+}
+
+// pageTemplate renders each pending session's diff (already
+// syntax-agnostic plain text; browsers get monospace via <pre>) and
+// test output alongside approve/reject buttons wired to the handlers
+// above.
+const pageTemplate = `<!doctype html>
+<title>fire-flow review</title>
+{{range $id, $p := .}}
+<h2>{{$id}} ({{$p.Decision}})</h2>
+<pre>{{range $p.Diff}}{{.}}
+{{end}}</pre>
+<pre>{{$p.TestOutput}}</pre>
+<form method="post" action="/approve?bead={{$id}}"><button>Approve</button></form>
+<form method="post" action="/reject?bead={{$id}}"><button>Reject</button></form>
+{{end}}
+`
@@ -0,0 +1,28 @@
+//go:build linux || darwin
+
+package scheduler
+
+import (
+	"fmt"
+	"plugin"
+)
+
+// LoadGoPlugin opens a .so built with `go build -buildmode=plugin` and
+// looks up an exported `Strategy` symbol implementing Strategy. Go
+// plugins only load on the OS/arch they were built for, so this is best
+// suited to fleets that build fire-flow and its plugins together.
+func LoadGoPlugin(path string) (Strategy, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening scheduler plugin %s: %w", path, err)
+	}
+	sym, err := p.Lookup("Strategy")
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s has no Strategy symbol: %w", path, err)
+	}
+	strat, ok := sym.(Strategy)
+	if !ok {
+		return nil, fmt.Errorf("plugin %s Strategy symbol does not implement scheduler.Strategy", path)
+	}
+	return strat, nil
+}
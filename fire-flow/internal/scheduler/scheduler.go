@@ -0,0 +1,54 @@
+// Package scheduler decides which bead to run next and when a stuck bead
+// should escalate. The default policy is built in, but teams that want a
+// custom prioritization scheme without forking fire-flow can supply one
+// via a Strategy implementation loaded from a Go plugin or a WASM module
+// (see plugin.go and wasm.go).
+package scheduler
+
+import "github.com/lprior-repo/fire-flow/internal/bead"
+
+// Candidate is a bead under consideration for the next run, along with
+// context a Strategy needs to rank it.
+type Candidate struct {
+	Issue        bead.Issue
+	RevertStreak int
+	AgeSeconds   int64
+}
+
+// Strategy picks the next bead to run and decides whether a failing bead
+// has earned escalation to a human.
+type Strategy interface {
+	// Next returns the index into candidates to run, or -1 if none
+	// should run right now.
+	Next(candidates []Candidate) int
+	// ShouldEscalate reports whether c has failed enough to stop
+	// retrying and hand off to a human.
+	ShouldEscalate(c Candidate) bool
+}
+
+// DefaultStrategy runs the highest-priority ready bead and escalates
+// after three consecutive reverts, matching the behavior fire-flow had
+// before strategies were pluggable.
+type DefaultStrategy struct {
+	MaxRevertStreak int
+}
+
+// NewDefaultStrategy returns the built-in strategy with its standard
+// escalation threshold.
+func NewDefaultStrategy() DefaultStrategy {
+	return DefaultStrategy{MaxRevertStreak: 3}
+}
+
+func (s DefaultStrategy) Next(candidates []Candidate) int {
+	best := -1
+	for i, c := range candidates {
+		if best == -1 || c.Issue.Priority > candidates[best].Issue.Priority {
+			best = i
+		}
+	}
+	return best
+}
+
+func (s DefaultStrategy) ShouldEscalate(c Candidate) bool {
+	return c.RevertStreak >= s.MaxRevertStreak
+}
@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// WASMStrategy runs scheduling policy compiled to WebAssembly, so a team
+// can ship a custom policy as a portable module instead of a
+// platform-specific Go plugin (see LoadGoPlugin). The module must export
+// `next(ptr, len) i32` and `should_escalate(ptr, len) i32`, each taking a
+// JSON-encoded argument written to the module's own memory by the caller,
+// plus `malloc(len)`, which the caller uses to allocate that memory.
+type WASMStrategy struct {
+	runtime  wazero.Runtime
+	module   api.Module
+	next     api.Function
+	escalate api.Function
+	malloc   api.Function
+}
+
+// LoadWASMStrategy compiles and instantiates a scheduler policy module.
+func LoadWASMStrategy(ctx context.Context, wasmBytes []byte) (*WASMStrategy, error) {
+	rt := wazero.NewRuntime(ctx)
+	mod, err := rt.Instantiate(ctx, wasmBytes)
+	if err != nil {
+		rt.Close(ctx)
+		return nil, fmt.Errorf("instantiating scheduler wasm module: %w", err)
+	}
+
+	required := map[string]*api.Function{
+		"next":            nil,
+		"should_escalate": nil,
+		"malloc":          nil,
+	}
+	for name := range required {
+		fn := mod.ExportedFunction(name)
+		if fn == nil {
+			rt.Close(ctx)
+			return nil, fmt.Errorf("scheduler wasm module missing export %q", name)
+		}
+		required[name] = &fn
+	}
+
+	return &WASMStrategy{
+		runtime:  rt,
+		module:   mod,
+		next:     *required["next"],
+		escalate: *required["should_escalate"],
+		malloc:   *required["malloc"],
+	}, nil
+}
+
+// Close releases the wasm runtime's resources.
+func (s *WASMStrategy) Close(ctx context.Context) error {
+	return s.runtime.Close(ctx)
+}
+
+func (s *WASMStrategy) writeJSON(ctx context.Context, v any) (uint64, uint64, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return 0, 0, fmt.Errorf("marshaling wasm argument: %w", err)
+	}
+	res, err := s.malloc.Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, 0, fmt.Errorf("calling wasm malloc: %w", err)
+	}
+	ptr := res[0]
+	if !s.module.Memory().Write(uint32(ptr), data) {
+		return 0, 0, fmt.Errorf("writing %d bytes to wasm memory at %d out of range", len(data), ptr)
+	}
+	return ptr, uint64(len(data)), nil
+}
+
+func (s *WASMStrategy) Next(candidates []Candidate) int {
+	ctx := context.Background()
+	ptr, size, err := s.writeJSON(ctx, candidates)
+	if err != nil {
+		return -1
+	}
+	res, err := s.next.Call(ctx, ptr, size)
+	if err != nil || len(res) == 0 {
+		return -1
+	}
+	return int(int32(res[0]))
+}
+
+func (s *WASMStrategy) ShouldEscalate(c Candidate) bool {
+	ctx := context.Background()
+	ptr, size, err := s.writeJSON(ctx, c)
+	if err != nil {
+		return false
+	}
+	res, err := s.escalate.Call(ctx, ptr, size)
+	if err != nil || len(res) == 0 {
+		return false
+	}
+	return res[0] != 0
+}
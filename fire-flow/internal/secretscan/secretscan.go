@@ -0,0 +1,141 @@
+// Package secretscan looks for credentials an AI attempt may have
+// hallucinated or copied into code before a cycle is allowed to commit
+// or push, since a leaked key in a generated diff is far more expensive
+// to clean up after it's in git history than to catch beforehand.
+package secretscan
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// Finding is one suspected credential, located precisely enough that a
+// human (or the gate's block message) can jump straight to it.
+type Finding struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Rule    string `json:"rule"`
+	Excerpt string `json:"excerpt"`
+}
+
+// rule is a named regex for a recognizable credential format. Patterns
+// are deliberately specific (prefixes, fixed lengths) to keep the false
+// positive rate low enough that a block is worth acting on.
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+var rules = []rule{
+	{"aws_access_key_id", regexp.MustCompile(`AKIA[0-9A-Z]{16}`)},
+	{"github_token", regexp.MustCompile(`gh[pousr]_[0-9A-Za-z]{36,}`)},
+	{"slack_token", regexp.MustCompile(`xox[baprs]-[0-9A-Za-z-]{10,}`)},
+	{"private_key_block", regexp.MustCompile(`-----BEGIN (RSA |EC |OPENSSH |)PRIVATE KEY-----`)},
+	{"generic_assignment", regexp.MustCompile(`(?i)(api[_-]?key|secret|token|password)\s*[:=]\s*['"][A-Za-z0-9+/_=-]{16,}['"]`)},
+}
+
+// entropyThreshold is the minimum Shannon entropy (bits per character)
+// a bare quoted string needs to be flagged as a likely secret, tuned so
+// English words and short identifiers stay below it while base64/hex
+// key material sits above it.
+const entropyThreshold = 4.2
+
+var quotedString = regexp.MustCompile(`['"]([A-Za-z0-9+/_=-]{20,})['"]`)
+
+// ScanFile scans one file's lines against the regex rules and, for
+// quoted strings not already caught by a named rule, a Shannon entropy
+// check, returning every match found.
+func ScanFile(path string) ([]Finding, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s for secret scan: %w", path, err)
+	}
+	defer f.Close()
+
+	var findings []Finding
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		matched := false
+		for _, r := range rules {
+			if r.pattern.MatchString(line) {
+				findings = append(findings, Finding{File: path, Line: lineNum, Rule: r.name, Excerpt: excerpt(line)})
+				matched = true
+			}
+		}
+		if matched {
+			continue
+		}
+
+		for _, m := range quotedString.FindAllStringSubmatch(line, -1) {
+			if shannonEntropy(m[1]) >= entropyThreshold {
+				findings = append(findings, Finding{File: path, Line: lineNum, Rule: "high_entropy_string", Excerpt: excerpt(line)})
+				break
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning %s for secrets: %w", path, err)
+	}
+	return findings, nil
+}
+
+// ScanFiles scans every file in rel (paths relative to baseDir, as
+// returned by overlay.Diff) and returns findings across all of them.
+func ScanFiles(baseDir string, rel []string) ([]Finding, error) {
+	var findings []Finding
+	for _, r := range rel {
+		path := filepath.Join(baseDir, r)
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		found, err := ScanFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for i := range found {
+			found[i].File = r
+		}
+		findings = append(findings, found...)
+	}
+	return findings, nil
+}
+
+// shannonEntropy computes the Shannon entropy of s in bits per
+// character, the standard measure for distinguishing random-looking
+// key material from ordinary text.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := map[rune]int{}
+	for _, r := range s {
+		counts[r]++
+	}
+	var entropy float64
+	length := float64(len(s))
+	for _, c := range counts {
+		p := float64(c) / length
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// excerpt trims line to a length safe to embed in a block message
+// without dumping an entire minified file into the terminal.
+func excerpt(line string) string {
+	const max = 160
+	if len(line) <= max {
+		return line
+	}
+	return line[:max] + "..."
+}
@@ -0,0 +1,30 @@
+package secretscan
+
+import "fmt"
+
+// Verdict mirrors gate.Verdict's shape without importing internal/gate,
+// the same decoupling depcheck.Verdict and vulncheck.Verdict use.
+type Verdict struct {
+	Allow   bool
+	Message string
+}
+
+// CheckFindings blocks on any finding at all: a single hit is enough to
+// warrant a human look before this reaches git history.
+func CheckFindings(findings []Finding) Verdict {
+	if len(findings) == 0 {
+		return Verdict{Allow: true}
+	}
+	return Verdict{Allow: false, Message: fmt.Sprintf("possible credentials found: %s", summarize(findings))}
+}
+
+func summarize(findings []Finding) string {
+	msg := ""
+	for i, f := range findings {
+		if i > 0 {
+			msg += ", "
+		}
+		msg += fmt.Sprintf("%s:%d (%s)", f.File, f.Line, f.Rule)
+	}
+	return msg
+}
@@ -0,0 +1,105 @@
+// Package service generates and manages the systemd user unit that runs
+// fire-flow's watch/serve daemon long-term on a runner host.
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const unitName = "fire-flow.service"
+
+// UnitOptions parameterizes the generated systemd unit.
+type UnitOptions struct {
+	ExecPath   string // path to the fire-flow binary
+	WorkingDir string
+	Args       string // extra args to `fire-flow serve`, e.g. "-unix-socket /run/fire-flow.sock"
+}
+
+// unitTemplate keeps PrivateTmp off because overlay mounts under
+// WorkingDir must be visible outside the unit's mount namespace, and
+// grants CAP_SYS_ADMIN since mounting overlayfs requires it.
+const unitTemplate = `[Unit]
+Description=fire-flow TCR enforcer daemon
+After=network.target
+
+[Service]
+Type=simple
+ExecStart=%s serve %s
+WorkingDirectory=%s
+Restart=on-failure
+PrivateTmp=false
+AmbientCapabilities=CAP_SYS_ADMIN
+NoNewPrivileges=false
+
+[Install]
+WantedBy=default.target
+`
+
+// unitPath returns the systemd user unit path for the current user.
+func unitPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolving home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "systemd", "user", unitName), nil
+}
+
+// Install writes the systemd user unit and reloads the daemon.
+func Install(opts UnitOptions) (string, error) {
+	path, err := unitPath()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("creating systemd user unit dir: %w", err)
+	}
+	unit := fmt.Sprintf(unitTemplate, opts.ExecPath, opts.Args, opts.WorkingDir)
+	if err := os.WriteFile(path, []byte(unit), 0o644); err != nil {
+		return "", fmt.Errorf("writing unit %s: %w", path, err)
+	}
+	if err := systemctl("daemon-reload"); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Start enables and starts the installed unit.
+func Start() error {
+	if err := systemctl("enable", "--now", unitName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Status returns `systemctl --user status` output for the unit.
+func Status() (string, error) {
+	out, err := exec.Command("systemctl", "--user", "status", unitName).CombinedOutput()
+	// systemctl status exits non-zero for "inactive" units; that's a
+	// valid, reportable status, not a real error.
+	return strings.TrimSpace(string(out)), func() error {
+		if err != nil && exitCode(err) > 4 {
+			return fmt.Errorf("checking status of %s: %w", unitName, err)
+		}
+		return nil
+	}()
+}
+
+func systemctl(args ...string) error {
+	full := append([]string{"--user"}, args...)
+	cmd := exec.Command("systemctl", full...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("systemctl --user %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func exitCode(err error) int {
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
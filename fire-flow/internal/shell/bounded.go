@@ -0,0 +1,76 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// BoundedOutput captures a subprocess's output for in-memory use (e.g.
+// error messages, teststate parsing) while writing the full stream to an
+// artifact file, so a process producing hundreds of MB of output never
+// gets fully buffered into a Go string.
+type BoundedOutput struct {
+	// HeadLimit and TailLimit cap how many bytes of the start and end of
+	// the stream are kept in Bytes(); everything in between is written
+	// only to the artifact file.
+	HeadLimit, TailLimit int
+	ArtifactPath         string
+
+	head, tail []byte
+	total      int64
+	file       *os.File
+}
+
+// NewBoundedOutput opens artifactPath for the full output and returns a
+// writer that also retains a head/tail summary in memory.
+func NewBoundedOutput(artifactPath string, headLimit, tailLimit int) (*BoundedOutput, error) {
+	f, err := os.Create(artifactPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating output artifact %s: %w", artifactPath, err)
+	}
+	return &BoundedOutput{HeadLimit: headLimit, TailLimit: tailLimit, ArtifactPath: artifactPath, file: f}, nil
+}
+
+// Write implements io.Writer, appending to the artifact file and to the
+// in-memory head/tail buffers.
+func (b *BoundedOutput) Write(p []byte) (int, error) {
+	if _, err := b.file.Write(p); err != nil {
+		return 0, fmt.Errorf("writing output artifact %s: %w", b.ArtifactPath, err)
+	}
+	b.total += int64(len(p))
+
+	if len(b.head) < b.HeadLimit {
+		room := b.HeadLimit - len(b.head)
+		if room > len(p) {
+			room = len(p)
+		}
+		b.head = append(b.head, p[:room]...)
+	}
+
+	b.tail = append(b.tail, p...)
+	if len(b.tail) > b.TailLimit {
+		b.tail = b.tail[len(b.tail)-b.TailLimit:]
+	}
+	return len(p), nil
+}
+
+// Close finalizes the artifact file.
+func (b *BoundedOutput) Close() error {
+	return b.file.Close()
+}
+
+// Summary returns a truncated view of the output suitable for logs and
+// error messages, noting how many bytes were elided and where the full
+// output is on disk.
+func (b *BoundedOutput) Summary() string {
+	elided := b.total - int64(len(b.head)) - int64(len(b.tail))
+	if elided <= 0 {
+		// head and tail overlap; keep only the tail bytes after head.
+		rest := b.total - int64(len(b.head))
+		return string(b.head) + string(b.tail[int64(len(b.tail))-rest:])
+	}
+	return fmt.Sprintf("%s\n... [%d bytes elided, full output at %s] ...\n%s", b.head, elided, b.ArtifactPath, b.tail)
+}
+
+var _ io.Writer = (*BoundedOutput)(nil)
@@ -0,0 +1,124 @@
+// Package shell provides a single hardened way to split and run shell
+// commands, so every subprocess call site (git, bd, opencode, test
+// runs) parses quoting correctly and shares one place to control
+// environment and timeouts, instead of each caller doing its own
+// strings.Fields split or exec.Command wiring.
+package shell
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+	"unicode"
+)
+
+// Split tokenizes a command line respecting single and double quotes and
+// backslash escapes, unlike strings.Fields which breaks on any quoted
+// argument containing spaces (e.g. `go test -run "TestFoo Bar"`).
+func Split(command string) ([]string, error) {
+	var (
+		args    []string
+		cur     []rune
+		hasCur  bool
+		quote   rune
+		escaped bool
+	)
+	flush := func() {
+		if hasCur {
+			args = append(args, string(cur))
+			cur = nil
+			hasCur = false
+		}
+	}
+	for _, r := range command {
+		switch {
+		case escaped:
+			cur = append(cur, r)
+			hasCur = true
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur = append(cur, r)
+				hasCur = true
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			hasCur = true
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			cur = append(cur, r)
+			hasCur = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %q quote in command: %s", quote, command)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash in command: %s", command)
+	}
+	flush()
+	return args, nil
+}
+
+// Config controls one subprocess invocation.
+type Config struct {
+	Dir string
+	Env []string // appended to the inherited environment; nil inherits only
+
+	// Trace, when set, records this
+	// invocation as an Event to the transcript at Trace.Path.
+	Trace *Recorder
+}
+
+// Run splits and executes a command line under cfg, returning combined
+// stdout+stderr. Every fire-flow subprocess call site should go through
+// Run (or RunArgv) instead of building its own exec.Command, so quoting
+// and environment handling stay consistent.
+func Run(ctx context.Context, command string, cfg Config) ([]byte, error) {
+	argv, err := Split(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(argv) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	return RunArgv(ctx, argv, cfg)
+}
+
+// RunArgv executes an explicit argv (preferred over Run when the caller
+// already has a config-defined argument list, since it skips shell-word
+// parsing entirely).
+func RunArgv(ctx context.Context, argv []string, cfg Config) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Dir = cfg.Dir
+	if cfg.Env != nil {
+		cmd.Env = append(os.Environ(), cfg.Env...)
+	}
+
+	started := time.Now()
+	out, runErr := cmd.CombinedOutput()
+	if cfg.Trace != nil {
+		if err := cfg.Trace.record(Event{
+			Argv:     argv,
+			Dir:      cfg.Dir,
+			EnvDelta: cfg.Env,
+			ExitCode: exitCode(runErr),
+			Duration: time.Since(started),
+			Output:   truncateOutput(out),
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "fire-flow: trace: %v\n", err)
+		}
+	}
+
+	if runErr != nil {
+		return out, fmt.Errorf("running %v: %w", argv, runErr)
+	}
+	return out, nil
+}
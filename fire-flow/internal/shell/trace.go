@@ -0,0 +1,80 @@
+package shell
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// maxTracedOutput caps how much of a subprocess's combined output a
+// transcript Event keeps, so a chatty command (a full test run, an AI
+// stream) doesn't blow up the transcript file.
+const maxTracedOutput = 4000
+
+// Event is one recorded subprocess invocation.
+type Event struct {
+	Argv     []string      `json:"argv"`
+	Dir      string        `json:"dir"`
+	EnvDelta []string      `json:"env_delta,omitempty"`
+	ExitCode int           `json:"exit_code"`
+	Duration time.Duration `json:"duration"`
+	Output   string        `json:"output"`
+}
+
+// Recorder appends Events to a JSONL transcript file when set as
+// Config.Trace, so a later "why did bd/opencode/git behave
+// differently" question has an after-the-fact answer instead of needing
+// the run reproduced. No fire-flow command or flag sets Config.Trace;
+// tracing happens only where a caller builds a Recorder itself.
+type Recorder struct {
+	Path string
+}
+
+// record appends e to the transcript, creating the file as needed.
+// Failures are returned rather than silently dropped, since a caller
+// that asked for tracing should know when it stopped working, but
+// RunArgv only logs them rather than failing the command itself — a
+// broken transcript file shouldn't take down the subprocess it's
+// observing.
+func (r *Recorder) record(e Event) error {
+	if r == nil {
+		return nil
+	}
+	f, err := os.OpenFile(r.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening transcript %s: %w", r.Path, err)
+	}
+	defer f.Close()
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshaling transcript event: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("appending to transcript %s: %w", r.Path, err)
+	}
+	return nil
+}
+
+// exitCode extracts the process exit code from a Run/RunArgv error,
+// returning 0 when err is nil (success) and -1 when the process never
+// produced an exit code at all (e.g. it couldn't be started).
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+func truncateOutput(out []byte) string {
+	if len(out) <= maxTracedOutput {
+		return string(out)
+	}
+	return string(out[:maxTracedOutput]) + fmt.Sprintf("... (truncated, %d bytes total)", len(out))
+}
@@ -0,0 +1,19 @@
+package state
+
+// SetPaused loads the current state, sets Paused to paused, and saves it
+// back.
+// While paused the TCR loop still keeps the overlay mounted (see
+// cmd/fire-flow/pause.go) but skips automatic test/commit/revert cycles,
+// so a manual refactor in progress doesn't get reverted out from under
+// the engineer doing it.
+func (s *Store) SetPaused(paused bool) (State, error) {
+	st, err := s.Load()
+	if err != nil {
+		return st, err
+	}
+	st.Paused = paused
+	if err := s.Save(st); err != nil {
+		return st, err
+	}
+	return st, nil
+}
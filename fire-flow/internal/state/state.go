@@ -0,0 +1,192 @@
+// Package state persists fire-flow's runtime state (mode, stats, paused
+// flag, outbox backlog) to state.json, with rotated backups so a corrupted write never
+// means starting over.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Sentinel errors so callers can branch with errors.Is instead of
+// matching on validate's or Load's message text.
+var (
+	// ErrInvalidMode means a State's Mode wasn't one of validModes.
+	ErrInvalidMode = errors.New("invalid mode")
+	// ErrNegativeStat means a State's Stats or OutboxPending held a
+	// negative value, which can only come from corruption or
+	// hand-editing.
+	ErrNegativeStat = errors.New("negative stat")
+	// ErrStateCorrupt means Load exhausted every rotated backup without
+	// finding one that parses and validates.
+	ErrStateCorrupt = errors.New("state corrupt and no valid backup found")
+)
+
+// State is fire-flow's persisted runtime state.
+type State struct {
+	Mode   string `json:"mode"`
+	Stats  Stats  `json:"stats"`
+	Paused bool   `json:"paused"`
+	// OutboxPending is the number of operations (pushes, bead status
+	// updates) queued in internal/offline's outbox waiting for
+	// connectivity, reported by the daemon's GET /status without reading
+	// the outbox file itself. No code in fire-flow writes it from the
+	// outbox; it holds whatever value was last saved.
+	OutboxPending int `json:"outbox_pending"`
+}
+
+// Stats mirrors the TCRStats shape from the TCR enforcer spec.
+type Stats struct {
+	Commits      int `json:"commits"`
+	Reverts      int `json:"reverts"`
+	RevertStreak int `json:"revert_streak"`
+}
+
+// Store manages a state.json file plus its rotated backups.
+type Store struct {
+	Path       string
+	MaxBackups int
+}
+
+// NewStore returns a Store that keeps up to maxBackups rotated copies of
+// path (path.1 is the most recent backup, path.N the oldest).
+func NewStore(path string, maxBackups int) *Store {
+	return &Store{Path: path, MaxBackups: maxBackups}
+}
+
+var validModes = map[string]bool{"tdd": true, "tcr": true, "both": true, "relaxed": true, "off": true, "": true}
+
+// validate rejects a State whose invariants don't hold: negative stats,
+// or a mode outside the documented TCRMode set. A state.json that parses
+// as valid JSON but violates these can only come from disk corruption or
+// hand-editing, and should be treated the same as a parse failure.
+func validate(st State) error {
+	if !validModes[st.Mode] {
+		return fmt.Errorf("mode %q: %w", st.Mode, ErrInvalidMode)
+	}
+	if st.Stats.Commits < 0 || st.Stats.Reverts < 0 || st.Stats.RevertStreak < 0 {
+		return fmt.Errorf("stats %+v: %w", st.Stats, ErrNegativeStat)
+	}
+	if st.OutboxPending < 0 {
+		return fmt.Errorf("outbox_pending %d: %w", st.OutboxPending, ErrNegativeStat)
+	}
+	return nil
+}
+
+// Load reads State from disk, returning a zero-value State if the file
+// doesn't exist yet. If the file is unparsable or fails validation, Load
+// attempts to repair by falling back through rotated backups (.1, .2, ...)
+// until one loads cleanly, returning an error only if none do.
+func (s *Store) Load() (State, error) {
+	st, err := s.loadFile(s.Path)
+	if err == nil {
+		return st, nil
+	}
+
+	for n := 1; n <= s.MaxBackups; n++ {
+		backup := s.backupPath(n)
+		if repaired, backupErr := s.loadFile(backup); backupErr == nil {
+			fmt.Fprintf(os.Stderr, "fire-flow: %s was corrupt (%v); repaired from backup .%d\n", s.Path, err, n)
+			return repaired, nil
+		}
+	}
+	return State{}, &CorruptError{Path: s.Path, Backups: s.MaxBackups, Err: err}
+}
+
+// CorruptError wraps the terminal failure of Store.Load when neither the
+// primary state file nor any rotated backup parsed and validated
+// cleanly, so callers can errors.As for Path/Backups instead of parsing
+// the message, and errors.Is(err, ErrStateCorrupt) to detect the case
+// generically.
+type CorruptError struct {
+	Path    string
+	Backups int
+	Err     error
+}
+
+func (e *CorruptError) Error() string {
+	return fmt.Sprintf("state %s is corrupt and no valid backup was found among %d: %v", e.Path, e.Backups, e.Err)
+}
+
+// Unwrap exposes both ErrStateCorrupt (for errors.Is) and the underlying
+// parse/validate failure (for errors.As), since Go 1.20+ lets Unwrap
+// return more than one error.
+func (e *CorruptError) Unwrap() []error { return []error{ErrStateCorrupt, e.Err} }
+
+func (s *Store) loadFile(path string) (State, error) {
+	var st State
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return st, nil
+	}
+	if err != nil {
+		return st, fmt.Errorf("reading state %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return st, fmt.Errorf("parsing state %s: %w", path, err)
+	}
+	if err := validate(st); err != nil {
+		return st, fmt.Errorf("validating state %s: %w", path, err)
+	}
+	return st, nil
+}
+
+// Save rotates existing backups (path.(n-1) -> path.n, ..., path -> path.1)
+// and then writes the new state to path, so a crash mid-write leaves at
+// worst a truncated path, with the previous state intact in path.1.
+func (s *Store) Save(st State) error {
+	if err := s.rotate(); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling state: %w", err)
+	}
+	if err := os.WriteFile(s.Path, data, 0o644); err != nil {
+		return fmt.Errorf("writing state %s: %w", s.Path, err)
+	}
+	return nil
+}
+
+func (s *Store) rotate() error {
+	if _, err := os.Stat(s.Path); os.IsNotExist(err) {
+		return nil
+	}
+	for n := s.MaxBackups; n >= 1; n-- {
+		from := s.backupPath(n - 1)
+		to := s.backupPath(n)
+		if _, err := os.Stat(from); os.IsNotExist(err) {
+			continue
+		}
+		if n == s.MaxBackups {
+			_ = os.Remove(to)
+		}
+		if err := os.Rename(from, to); err != nil {
+			return fmt.Errorf("rotating state backup %s -> %s: %w", from, to, err)
+		}
+	}
+	return nil
+}
+
+// backupPath returns path for n==0, and "path.N" for n>=1.
+func (s *Store) backupPath(n int) string {
+	if n == 0 {
+		return s.Path
+	}
+	return fmt.Sprintf("%s.%d", s.Path, n)
+}
+
+// Restore replaces the current state.json with backup n (1..MaxBackups).
+func (s *Store) Restore(n int) error {
+	backup := s.backupPath(n)
+	data, err := os.ReadFile(backup)
+	if err != nil {
+		return fmt.Errorf("reading state backup %s: %w", backup, err)
+	}
+	if err := os.WriteFile(s.Path, data, 0o644); err != nil {
+		return fmt.Errorf("restoring state from %s: %w", backup, err)
+	}
+	return nil
+}
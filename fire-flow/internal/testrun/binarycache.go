@@ -0,0 +1,80 @@
+// Package testrun precompiles and reuses `go test -c` binaries across
+// cycles so an unchanged package doesn't pay compilation cost every
+// time fire-flow's TCR loop runs its tests.
+package testrun
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+)
+
+// BinaryCache maps a package's source hash (test files included) to a
+// precompiled test binary on disk.
+type BinaryCache struct {
+	Dir string
+}
+
+// NewBinaryCache ensures dir exists for storing compiled test binaries.
+func NewBinaryCache(dir string) (*BinaryCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating test binary cache dir %s: %w", dir, err)
+	}
+	return &BinaryCache{Dir: dir}, nil
+}
+
+// sourceHash hashes every .go file in pkgDir, _test.go files included,
+// so any change to the package's source or its tests invalidates the
+// cached binary; both are compiled into the test binary.
+func sourceHash(pkgDir string) (string, error) {
+	entries, err := os.ReadDir(pkgDir)
+	if err != nil {
+		return "", fmt.Errorf("reading package dir %s: %w", pkgDir, err)
+	}
+	var files []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || filepath.Ext(name) != ".go" {
+			continue
+		}
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join(pkgDir, name))
+		if err != nil {
+			return "", fmt.Errorf("hashing %s: %w", name, err)
+		}
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Binary returns the path to a compiled test binary for pkgDir,
+// building it with `go test -c` only if its source hash has changed
+// since the last build.
+func (c *BinaryCache) Binary(pkgDir string) (string, error) {
+	hash, err := sourceHash(pkgDir)
+	if err != nil {
+		return "", err
+	}
+	out := filepath.Join(c.Dir, hash+".test")
+	if _, err := os.Stat(out); err == nil {
+		return out, nil
+	}
+
+	cmd := exec.Command("go", "test", "-c", "-o", out, ".")
+	cmd.Dir = pkgDir
+	if combined, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("compiling test binary for %s: %w: %s", pkgDir, err, combined)
+	}
+	return out, nil
+}
@@ -0,0 +1,14 @@
+package testrun
+
+import "regexp"
+
+// SynthesizeRunCommand builds the exact `go test -run '^name$' pkgDir`
+// argv fire-flow runs when the gate demands exactly one test, escaping
+// and anchoring testName itself rather than trusting an agent-supplied
+// command or template substitution to target only that test: a raw
+// substitution could over-match (testName is a prefix of other test
+// names) or under-match (testName contains regex metacharacters).
+func SynthesizeRunCommand(pkgDir, testName string) []string {
+	pattern := "^" + regexp.QuoteMeta(testName) + "$"
+	return []string{"go", "test", "-run", pattern, pkgDir}
+}
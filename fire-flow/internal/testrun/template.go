@@ -0,0 +1,31 @@
+package testrun
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// CommandVars are the placeholders available in a configured
+// testCommand template, letting one config express targeted runs (a
+// single package or test) and non-Go frameworks without hard-coding
+// shell string-building per case.
+type CommandVars struct {
+	Package   string
+	TestName  string
+	MergedDir string
+}
+
+// ExpandCommand renders a testCommand template (e.g.
+// `go test -run {{.TestName}} {{.Package}}`) against vars.
+func ExpandCommand(commandTemplate string, vars CommandVars) (string, error) {
+	tmpl, err := template.New("testCommand").Parse(commandTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parsing testCommand template %q: %w", commandTemplate, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", fmt.Errorf("expanding testCommand template %q: %w", commandTemplate, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,57 @@
+package testsession
+
+// DurationDelta is how much slower (positive) or faster (negative) a
+// test ran in After compared to Before.
+type DurationDelta struct {
+	Package string  `json:"package"`
+	Name    string  `json:"name"`
+	Before  float64 `json:"before_seconds"`
+	After   float64 `json:"after_seconds"`
+	Delta   float64 `json:"delta_seconds"`
+}
+
+// Comparison is the result of diffing two labeled Sessions.
+type Comparison struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+	// NewlyFailing lists tests that passed in Before and failed in
+	// After — the signal that matters most when evaluating an AI
+	// attempt against its pre-change baseline.
+	NewlyFailing []string `json:"newly_failing"`
+	// NewlyPassing lists tests that failed in Before and passed in
+	// After.
+	NewlyPassing []string `json:"newly_passing"`
+	// DurationDeltas covers every test present in both sessions,
+	// regardless of pass/fail status.
+	DurationDeltas []DurationDelta `json:"duration_deltas"`
+}
+
+// Compare diffs before against after by test key (package + name).
+func Compare(before, after Session) Comparison {
+	c := Comparison{Before: before.Label, After: after.Label}
+
+	beforeByKey := make(map[string]TestRecord, len(before.Tests))
+	for _, t := range before.Tests {
+		beforeByKey[t.key()] = t
+	}
+
+	for _, a := range after.Tests {
+		b, ok := beforeByKey[a.key()]
+		if !ok {
+			continue
+		}
+		if b.Passed && !a.Passed {
+			c.NewlyFailing = append(c.NewlyFailing, a.key())
+		} else if !b.Passed && a.Passed {
+			c.NewlyPassing = append(c.NewlyPassing, a.key())
+		}
+		c.DurationDeltas = append(c.DurationDeltas, DurationDelta{
+			Package: a.Package,
+			Name:    a.Name,
+			Before:  b.Elapsed,
+			After:   a.Elapsed,
+			Delta:   a.Elapsed - b.Elapsed,
+		})
+	}
+	return c
+}
@@ -0,0 +1,92 @@
+// Package testsession persists labeled `go test -json` runs to disk so
+// two runs (typically a pre-change baseline and an AI attempt) can be
+// diffed by name later, independent of internal/teststate's run-scoped
+// Result.
+package testsession
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/lprior-repo/fire-flow/internal/teststate"
+)
+
+// TestRecord is one test's terminal outcome within a Session.
+type TestRecord struct {
+	Package string  `json:"package"`
+	Name    string  `json:"name"`
+	Passed  bool    `json:"passed"`
+	Elapsed float64 `json:"elapsed_seconds"`
+}
+
+// key identifies a test within a Session the same way teststate keys
+// FailedTests: package-qualified, since two packages can share a test
+// name.
+func (r TestRecord) key() string { return r.Package + "." + r.Name }
+
+// Session is one labeled `go test -json` run.
+type Session struct {
+	Label string       `json:"label"`
+	Tests []TestRecord `json:"tests"`
+}
+
+// BuildSession runs `go test -json` output from r through
+// teststate.ParseStream, keeping each test's final pass/fail and
+// elapsed time as label's Session.
+func BuildSession(ctx context.Context, label string, r io.Reader) (Session, error) {
+	s := Session{Label: label}
+	byKey := map[string]int{}
+	err := teststate.ParseStream(ctx, r, func(ev teststate.Event) {
+		if ev.Unparsable || ev.Test == "" {
+			return
+		}
+		switch ev.Action {
+		case "pass", "fail":
+			rec := TestRecord{Package: ev.Package, Name: ev.Test, Passed: ev.Action == "pass", Elapsed: ev.Elapsed}
+			if i, ok := byKey[rec.key()]; ok {
+				s.Tests[i] = rec
+				return
+			}
+			byKey[rec.key()] = len(s.Tests)
+			s.Tests = append(s.Tests, rec)
+		}
+	})
+	return s, err
+}
+
+// path returns dir/label.json.
+func path(dir, label string) string {
+	return filepath.Join(dir, label+".json")
+}
+
+// Save writes s to dir/<label>.json, creating dir as needed.
+func Save(dir string, s Session) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating test session dir %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling test session %s: %w", s.Label, err)
+	}
+	if err := os.WriteFile(path(dir, s.Label), data, 0o644); err != nil {
+		return fmt.Errorf("writing test session to %s: %w", path(dir, s.Label), err)
+	}
+	return nil
+}
+
+// Load reads back a previously Saved session by label.
+func Load(dir, label string) (Session, error) {
+	data, err := os.ReadFile(path(dir, label))
+	if err != nil {
+		return Session{}, fmt.Errorf("reading test session %s: %w", label, err)
+	}
+	var s Session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Session{}, fmt.Errorf("parsing test session %s: %w", label, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,75 @@
+package teststate
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"unicode/utf8"
+)
+
+// maxLineSize bounds a single scanned line well above anything a real
+// `go test -json` event needs (its Output field is usually one source
+// line), so only a line longer than 16 MiB makes the bufio.Scanner stop
+// with ErrTooLong, which ParseStream returns as its error.
+const maxLineSize = 16 * 1024 * 1024
+
+// Event is a single decoded `go test -json` event, exported so
+// ParseStream's callers (e.g. the live-progress runner) can react as
+// each test finishes instead of waiting for the whole run to end.
+type Event struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	// Output carries an "output" action's raw text, which is where `go
+	// test` reports per-package coverage ("coverage: 85.3% of
+	// statements") rather than as its own structured event.
+	Output string
+	// Unparsable is set when the source line wasn't valid UTF-8 or valid
+	// JSON; every other field is zero in that case.
+	Unparsable bool
+}
+
+// rawEvent mirrors the subset of `go test -json`'s TestEvent schema
+// (cmd/test2json) that Event needs.
+type rawEvent struct {
+	Action  string  `json:"Action"`
+	Package string  `json:"Package"`
+	Test    string  `json:"Test"`
+	Elapsed float64 `json:"Elapsed"`
+	Output  string  `json:"Output"`
+}
+
+// ParseStream reads NDJSON `go test -json` events from r one line at a
+// time, calling emit for each decoded (or unparsable) event as it's
+// read, rather than buffering the whole run in memory first. It returns
+// when ctx is found done (checked each time a line has been read, so a
+// read that blocks is not interrupted), or when r is exhausted, or on a
+// read error from r itself; a malformed line is never fatal, matching
+// ParseGoTestOutput's panic-free contract.
+func ParseStream(ctx context.Context, r io.Reader, emit func(Event)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if !utf8.Valid(line) {
+			emit(Event{Unparsable: true})
+			continue
+		}
+		var raw rawEvent
+		if err := json.Unmarshal(line, &raw); err != nil {
+			emit(Event{Unparsable: true})
+			continue
+		}
+		emit(Event{Action: raw.Action, Package: raw.Package, Test: raw.Test, Elapsed: raw.Elapsed, Output: raw.Output})
+	}
+	return scanner.Err()
+}
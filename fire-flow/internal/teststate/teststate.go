@@ -0,0 +1,121 @@
+// Package teststate parses `go test -json` output into the pass/fail
+// summary the TCR loop decides on. AI-generated test commands run
+// arbitrary, sometimes adversarial byte streams through this parser, so
+// it never panics: malformed lines are recorded and skipped rather than
+// aborting the whole run.
+package teststate
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// coveragePattern matches the line `go test` prints per package, e.g.
+// "coverage: 85.3% of statements".
+var coveragePattern = regexp.MustCompile(`coverage:\s+(\d+(?:\.\d+)?)% of statements`)
+
+// PackageResult aggregates one package's tests and coverage, letting
+// gates reason at package granularity instead of only individual test
+// names.
+type PackageResult struct {
+	Passed  int
+	Failed  int
+	Skipped int
+	// Coverage is the percentage from `go test`'s "coverage: N% of
+	// statements" line. HasCoverage is false when the package didn't
+	// report one (e.g. it built with -cover disabled).
+	Coverage    float64
+	HasCoverage bool
+}
+
+// Result is the parsed outcome of a `go test -json` run.
+type Result struct {
+	Passed      int
+	Failed      int
+	Skipped     int
+	FailedTests []string
+	// Packages aggregates Passed/Failed/Skipped/Coverage per package
+	// import path, in addition to the run-wide totals above.
+	Packages map[string]*PackageResult
+	// UnparsableLines counts input lines that weren't valid JSON or
+	// weren't valid UTF-8, so a malformed test runner doesn't silently
+	// produce a Result that looks clean.
+	UnparsableLines int
+}
+
+// ParseGoTestOutput reads NDJSON `go test -json` events from r and
+// summarizes them into a Result. It's a thin wrapper over ParseStream
+// for callers that just want the final tally; a caller that wants
+// per-test progress as it happens should call ParseStream directly.
+func ParseGoTestOutput(r io.Reader) (Result, error) {
+	return ParseGoTestOutputContext(context.Background(), r)
+}
+
+// ParseGoTestOutputContext is ParseGoTestOutput with a context, so a
+// long-running `go test` can be abandoned between output lines; a read
+// from r that blocks is not interrupted by ctx.
+func ParseGoTestOutputContext(ctx context.Context, r io.Reader) (Result, error) {
+	res := Result{Packages: map[string]*PackageResult{}}
+	err := ParseStream(ctx, r, func(ev Event) {
+		if ev.Unparsable {
+			res.UnparsableLines++
+			return
+		}
+		applyEvent(&res, ev)
+	})
+	return res, err
+}
+
+// applyEvent folds a single top-level test event into res. Package-level
+// pass/fail/skip actions (Test == "") are ignored for the run-wide
+// totals, matching how `go test -json` reports a package's own result as
+// a redundant roll-up of its tests', but their Output is still scanned
+// for a coverage line since that's where coverage is only ever reported.
+func applyEvent(res *Result, ev Event) {
+	if ev.Package != "" && ev.Output != "" {
+		if m := coveragePattern.FindStringSubmatch(ev.Output); m != nil {
+			if pct, err := strconv.ParseFloat(m[1], 64); err == nil {
+				pkg := packageResult(res, ev.Package)
+				pkg.Coverage = pct
+				pkg.HasCoverage = true
+			}
+		}
+	}
+	if ev.Test == "" {
+		return
+	}
+	var pkg *PackageResult
+	if ev.Package != "" {
+		pkg = packageResult(res, ev.Package)
+	}
+	switch ev.Action {
+	case "pass":
+		res.Passed++
+		if pkg != nil {
+			pkg.Passed++
+		}
+	case "fail":
+		res.Failed++
+		res.FailedTests = append(res.FailedTests, ev.Package+"."+ev.Test)
+		if pkg != nil {
+			pkg.Failed++
+		}
+	case "skip":
+		res.Skipped++
+		if pkg != nil {
+			pkg.Skipped++
+		}
+	}
+}
+
+// packageResult returns res.Packages[name], creating it on first use.
+func packageResult(res *Result, name string) *PackageResult {
+	pkg, ok := res.Packages[name]
+	if !ok {
+		pkg = &PackageResult{}
+		res.Packages[name] = pkg
+	}
+	return pkg
+}
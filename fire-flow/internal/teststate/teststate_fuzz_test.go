@@ -0,0 +1,25 @@
+package teststate
+
+import (
+	"strings"
+	"testing"
+)
+
+// FuzzParseGoTestOutput feeds ParseGoTestOutput adversarial byte streams
+// (huge lines, deeply nested JSON, invalid UTF-8) to guard the "never
+// panics" contract in ParseGoTestOutput's doc comment, since a crash
+// here would take down the whole enforcer mid-cycle.
+func FuzzParseGoTestOutput(f *testing.F) {
+	f.Add(`{"Action":"pass","Package":"pkg","Test":"TestFoo"}` + "\n")
+	f.Add(`{"Action":"fail","Package":"pkg","Test":"TestBar","Elapsed":0.1}` + "\n")
+	f.Add("not json at all\n")
+	f.Add(strings.Repeat(`{"a":`, 10000) + "1" + strings.Repeat("}", 10000))
+	f.Add(string([]byte{0xff, 0xfe, 0x00, 0x80}))
+	f.Add(strings.Repeat("x", 1<<20))
+
+	f.Fuzz(func(t *testing.T, input string) {
+		if _, err := ParseGoTestOutput(strings.NewReader(input)); err != nil {
+			t.Skip("reader-level error is an acceptable outcome, not a bug")
+		}
+	})
+}
@@ -0,0 +1,122 @@
+// Package toolchain reads a repo's declared Go toolchain from go.mod
+// and compares it against the actual `go` on a host's PATH, so a worker
+// with a newer Go than CI doesn't silently produce different test
+// results than the version the module actually declares.
+package toolchain
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// Declared is the toolchain a go.mod file pins.
+type Declared struct {
+	// GoVersion is the `go` directive, e.g. "1.22".
+	GoVersion string
+	// Toolchain is the `toolchain` directive, e.g. "go1.22.3"; empty
+	// when go.mod doesn't set one, in which case GoVersion is the only
+	// constraint.
+	Toolchain string
+}
+
+var (
+	goDirective         = regexp.MustCompile(`^go\s+(\S+)`)
+	toolchainDirective  = regexp.MustCompile(`^toolchain\s+(\S+)`)
+	actualVersionSuffix = regexp.MustCompile(`go(\d+\.\d+(?:\.\d+)?)`)
+)
+
+// ReadGoMod reads and parses the go/toolchain directives from the
+// go.mod at path.
+func ReadGoMod(path string) (Declared, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Declared{}, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return ParseGoMod(data), nil
+}
+
+// ParseGoMod extracts the go/toolchain directives from go.mod content.
+// It never errors: a go.mod missing either directive just yields a
+// Declared with that field empty.
+func ParseGoMod(data []byte) Declared {
+	var d Declared
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if m := goDirective.FindStringSubmatch(line); m != nil {
+			d.GoVersion = m[1]
+			continue
+		}
+		if m := toolchainDirective.FindStringSubmatch(line); m != nil {
+			d.Toolchain = m[1]
+		}
+	}
+	return d
+}
+
+// Env returns the environment variable to set on a test subprocess so
+// `go` itself resolves to the declared toolchain (auto-downloading it
+// if needed) instead of trusting whatever version happens to be on
+// PATH. Returns nil when go.mod declares no version at all.
+func (d Declared) Env() []string {
+	switch {
+	case d.Toolchain != "":
+		return []string{"GOTOOLCHAIN=" + d.Toolchain}
+	case d.GoVersion != "":
+		return []string{"GOTOOLCHAIN=go" + d.GoVersion}
+	default:
+		return nil
+	}
+}
+
+// ActualVersion runs `go version` and extracts its version number
+// (e.g. "1.22.3" from "go version go1.22.3 linux/amd64").
+func ActualVersion(ctx context.Context) (string, error) {
+	out, err := exec.CommandContext(ctx, "go", "version").Output()
+	if err != nil {
+		return "", fmt.Errorf("running go version: %w", err)
+	}
+	m := actualVersionSuffix.FindStringSubmatch(string(out))
+	if m == nil {
+		return "", fmt.Errorf("unrecognized `go version` output: %q", strings.TrimSpace(string(out)))
+	}
+	return m[1], nil
+}
+
+// Mismatch reports whether actualVersion (from ActualVersion) falls
+// short of d's declared minimum Go version, and a remediation message
+// when it does. It only checks GoVersion, the minimum every toolchain must
+// meet; Toolchain pinning itself is enforced by Env, not by this check.
+func Mismatch(actualVersion string, d Declared) (bool, string) {
+	if d.GoVersion == "" {
+		return false, ""
+	}
+	if !versionAtLeast(actualVersion, d.GoVersion) {
+		return true, fmt.Sprintf("go.mod declares go %s but this host's `go` is %s; set GOTOOLCHAIN=go%s or install a newer Go", d.GoVersion, actualVersion, d.GoVersion)
+	}
+	return false, ""
+}
+
+// versionAtLeast reports whether actual >= want, comparing dotted
+// version numbers component by component (so "1.9" < "1.10").
+func versionAtLeast(actual, want string) bool {
+	a := strings.Split(actual, ".")
+	w := strings.Split(want, ".")
+	for i := 0; i < len(w); i++ {
+		if i >= len(a) {
+			return false
+		}
+		var av, wv int
+		fmt.Sscanf(a[i], "%d", &av)
+		fmt.Sscanf(w[i], "%d", &wv)
+		if av != wv {
+			return av > wv
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GitHubProvider talks to the GitHub REST API for one repository. Token
+// is expected to come from an environment variable at the call site
+// (e.g. GITHUB_TOKEN), never hardcoded, matching how gitops.AuthConfig
+// keeps credentials out of fire-flow's own config files.
+type GitHubProvider struct {
+	Repo  string // "owner/name"
+	Token string
+	HTTP  *http.Client
+}
+
+// FetchIssues returns open issues carrying the given label.
+func (p GitHubProvider) FetchIssues(label string) ([]RemoteIssue, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues?state=open&labels=%s", p.Repo, label)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building GitHub issues request: %w", err)
+	}
+	p.authorize(req)
+
+	var raw []struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Body   string `json:"body"`
+		Labels []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
+	}
+	if err := p.doJSON(req, &raw); err != nil {
+		return nil, fmt.Errorf("fetching GitHub issues for %s: %w", p.Repo, err)
+	}
+
+	issues := make([]RemoteIssue, 0, len(raw))
+	for _, r := range raw {
+		labels := make([]string, 0, len(r.Labels))
+		for _, l := range r.Labels {
+			labels = append(labels, l.Name)
+		}
+		issues = append(issues, RemoteIssue{
+			Ref:    fmt.Sprintf("gh:%s#%d", p.Repo, r.Number),
+			Title:  r.Title,
+			Body:   r.Body,
+			Labels: labels,
+		})
+	}
+	return issues, nil
+}
+
+// Comment posts body as a comment on the issue identified by ref
+// (as returned in RemoteIssue.Ref).
+func (p GitHubProvider) Comment(ref, body string) error {
+	number, err := issueNumber(ref)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%s/comments", p.Repo, number)
+	payload, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return fmt.Errorf("marshaling GitHub comment: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("building GitHub comment request: %w", err)
+	}
+	p.authorize(req)
+	return p.doJSON(req, nil)
+}
+
+// Transition closes or reopens the GitHub issue; status must be "open"
+// or "closed", GitHub's own vocabulary, since GitHub has no richer
+// workflow states to map onto.
+func (p GitHubProvider) Transition(ref, status string) error {
+	number, err := issueNumber(ref)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%s", p.Repo, number)
+	payload, err := json.Marshal(map[string]string{"state": status})
+	if err != nil {
+		return fmt.Errorf("marshaling GitHub transition: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("building GitHub transition request: %w", err)
+	}
+	p.authorize(req)
+	return p.doJSON(req, nil)
+}
+
+func (p GitHubProvider) authorize(req *http.Request) {
+	if p.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Token)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+}
+
+func (p GitHubProvider) doJSON(req *http.Request, out any) error {
+	client := p.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("GitHub API returned status %d", resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,111 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// JiraProvider talks to the Jira Cloud REST API for one site/project.
+// Token is an API token, expected from an environment variable at the
+// call site (e.g. JIRA_TOKEN), never hardcoded.
+type JiraProvider struct {
+	BaseURL string // e.g. "https://example.atlassian.net"
+	Email   string // Jira Cloud basic auth pairs an email with the token
+	Token   string
+	HTTP    *http.Client
+}
+
+// FetchIssues returns issues matching a JQL query.
+func (p JiraProvider) FetchIssues(jql string) ([]RemoteIssue, error) {
+	url := fmt.Sprintf("%s/rest/api/3/search?jql=%s", p.BaseURL, jql)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building Jira search request: %w", err)
+	}
+	p.authorize(req)
+
+	var raw struct {
+		Issues []struct {
+			Key    string `json:"key"`
+			Fields struct {
+				Summary     string   `json:"summary"`
+				Description string   `json:"description"`
+				Labels      []string `json:"labels"`
+			} `json:"fields"`
+		} `json:"issues"`
+	}
+	if err := p.doJSON(req, &raw); err != nil {
+		return nil, fmt.Errorf("searching Jira %s: %w", jql, err)
+	}
+
+	issues := make([]RemoteIssue, 0, len(raw.Issues))
+	for _, r := range raw.Issues {
+		issues = append(issues, RemoteIssue{
+			Ref:    r.Key,
+			Title:  r.Fields.Summary,
+			Body:   r.Fields.Description,
+			Labels: r.Fields.Labels,
+		})
+	}
+	return issues, nil
+}
+
+// Comment posts body as a comment on the Jira issue ref.
+func (p JiraProvider) Comment(ref, body string) error {
+	url := fmt.Sprintf("%s/rest/api/3/issue/%s/comment", p.BaseURL, ref)
+	payload, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return fmt.Errorf("marshaling Jira comment: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("building Jira comment request: %w", err)
+	}
+	p.authorize(req)
+	return p.doJSON(req, nil)
+}
+
+// Transition applies the Jira workflow transition whose ID is status.
+// Jira transitions are IDs, not names, in the raw API; callers are
+// expected to pass the transition ID their workflow uses (fire-flow
+// doesn't attempt to resolve names to IDs, since that mapping is
+// per-project configuration).
+func (p JiraProvider) Transition(ref, status string) error {
+	url := fmt.Sprintf("%s/rest/api/3/issue/%s/transitions", p.BaseURL, ref)
+	payload, err := json.Marshal(map[string]any{"transition": map[string]string{"id": status}})
+	if err != nil {
+		return fmt.Errorf("marshaling Jira transition: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("building Jira transition request: %w", err)
+	}
+	p.authorize(req)
+	return p.doJSON(req, nil)
+}
+
+func (p JiraProvider) authorize(req *http.Request) {
+	req.SetBasicAuth(p.Email, p.Token)
+	req.Header.Set("Content-Type", "application/json")
+}
+
+func (p JiraProvider) doJSON(req *http.Request, out any) error {
+	client := p.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("Jira API returned status %d", resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,49 @@
+package tracker
+
+import "fmt"
+
+// StatusUpdate is what triggers a sync back to the originating tracker:
+// a bead moved to a new status, optionally with the commit and test
+// evidence for that transition.
+type StatusUpdate struct {
+	BeadStatus  string // fire-flow's bead status, e.g. "in_progress", "closed"
+	CommitSHA   string // empty if the transition wasn't tied to a commit
+	TestSummary string // e.g. "12 passed, 0 failed"
+}
+
+// TransitionMap says which tracker-native status/transition a given
+// bead status should map to, since GitHub ("open"/"closed") and Jira
+// (workflow transition IDs) speak different vocabularies. Configured
+// per tracker, since two teams using the same provider can still run
+// different workflows.
+type TransitionMap map[string]string
+
+// Sync comments progress on ref and, if update.BeadStatus has an entry
+// in transitions, transitions the tracker issue to match. It's built to
+// be called from the cycle engine right after a commit or bead status
+// change, so humans watching the tracker see automation progress
+// without needing to know fire-flow exists.
+func Sync(provider Provider, ref string, update StatusUpdate, transitions TransitionMap) error {
+	if err := provider.Comment(ref, commentBody(update)); err != nil {
+		return fmt.Errorf("commenting on %s: %w", ref, err)
+	}
+	native, ok := transitions[update.BeadStatus]
+	if !ok {
+		return nil
+	}
+	if err := provider.Transition(ref, native); err != nil {
+		return fmt.Errorf("transitioning %s to %s: %w", ref, native, err)
+	}
+	return nil
+}
+
+func commentBody(update StatusUpdate) string {
+	body := fmt.Sprintf("fire-flow: status -> %s", update.BeadStatus)
+	if update.CommitSHA != "" {
+		body += fmt.Sprintf("\ncommit: %s", update.CommitSHA)
+	}
+	if update.TestSummary != "" {
+		body += fmt.Sprintf("\ntests: %s", update.TestSummary)
+	}
+	return body
+}
@@ -0,0 +1,94 @@
+// Package tracker imports work items from external issue trackers
+// (GitHub Issues, Jira) as beads, and later syncs bead status changes
+// back to the originating tracker so humans following the tracker see
+// automation progress without watching .beads/*.jsonl directly.
+package tracker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RemoteIssue is a tracker-agnostic view of one issue, after a Provider
+// has translated its native representation.
+type RemoteIssue struct {
+	Ref    string // tracker-native identifier, e.g. "gh:owner/repo#123" or "PROJ-456"
+	Title  string
+	Body   string
+	Labels []string
+}
+
+// Provider fetches issues and reports bead status changes back to one
+// tracker (GitHub Issues, Jira, ...).
+type Provider interface {
+	// FetchIssues returns issues matching query (a label for GitHub, a
+	// JQL string for Jira).
+	FetchIssues(query string) ([]RemoteIssue, error)
+	// Comment posts a progress comment on the tracker issue.
+	Comment(ref, body string) error
+	// Transition moves the tracker issue to status, in whatever terms
+	// the tracker itself uses (a GitHub state, a Jira transition ID).
+	Transition(ref, status string) error
+}
+
+// Import is the bead-shaped result of converting a RemoteIssue: a title
+// and description ready for bead.AppendProposal, plus the extracted
+// acceptance criteria and cross-references so dependency links can be
+// wired up by the caller.
+type Import struct {
+	Title              string
+	Description        string
+	AcceptanceCriteria []string
+	References         []string // other issue refs mentioned in the body, e.g. "#123"
+	SourceRef          string
+}
+
+var (
+	acceptanceHeading = regexp.MustCompile(`(?im)^#+\s*acceptance criteria\s*$`)
+	anyHeading        = regexp.MustCompile(`(?m)^#+\s`)
+	checklistItem     = regexp.MustCompile(`(?m)^\s*[-*]\s*\[[ xX]\]\s*(.+)$`)
+	issueReference    = regexp.MustCompile(`#(\d+)`)
+)
+
+// ToImport converts a RemoteIssue into an Import: it pulls acceptance
+// criteria out of a "## Acceptance Criteria" section (checklist items),
+// and dependency links out of "#123"-style references anywhere in the
+// body, so `beads import` doesn't lose either when flattening the issue
+// into a bead's plain description field.
+func ToImport(issue RemoteIssue) Import {
+	imp := Import{
+		Title:       issue.Title,
+		Description: issue.Body,
+		SourceRef:   issue.Ref,
+	}
+
+	if loc := acceptanceHeading.FindStringIndex(issue.Body); loc != nil {
+		section := issue.Body[loc[1]:]
+		if next := anyHeading.FindStringIndex(section); next != nil {
+			section = section[:next[0]]
+		}
+		for _, m := range checklistItem.FindAllStringSubmatch(section, -1) {
+			imp.AcceptanceCriteria = append(imp.AcceptanceCriteria, strings.TrimSpace(m[1]))
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, m := range issueReference.FindAllString(issue.Body, -1) {
+		if !seen[m] {
+			seen[m] = true
+			imp.References = append(imp.References, m)
+		}
+	}
+	return imp
+}
+
+// issueNumber extracts the trailing "#N" issue number from a GitHub
+// RemoteIssue.Ref of the form "gh:owner/repo#N".
+func issueNumber(ref string) (string, error) {
+	idx := strings.LastIndex(ref, "#")
+	if idx < 0 || idx == len(ref)-1 {
+		return "", fmt.Errorf("tracker: %q is not a GitHub issue ref", ref)
+	}
+	return ref[idx+1:], nil
+}
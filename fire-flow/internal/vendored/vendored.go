@@ -0,0 +1,44 @@
+// Package vendored defines the vendored-directory patterns (vendor/,
+// third_party/) that fire-flow's default commit ignore list (see
+// config.DefaultCommitConfig) includes, so overlay commits and diff
+// reports leave them out unless CommitAllow lets them through. The file
+// watcher skips them only when its Coalescer.IgnorePatterns is set, and
+// bead.Suggest's change budget counts every file it is given.
+package vendored
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// DefaultPatterns are the vendored-directory names excluded by default.
+var DefaultPatterns = []string{"vendor", "third_party"}
+
+// Match reports whether rel falls under any of patterns, checking both
+// the full relative path and each path segment so "vendor" matches
+// "vendor/github.com/foo/bar.go" too.
+func Match(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		for _, part := range strings.Split(rel, string(filepath.Separator)) {
+			if ok, _ := filepath.Match(pattern, part); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Filter returns paths with every entry matching patterns removed, for
+// callers that need a plain slice rather than a per-path predicate.
+func Filter(paths []string, patterns []string) []string {
+	var kept []string
+	for _, p := range paths {
+		if !Match(p, patterns) {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
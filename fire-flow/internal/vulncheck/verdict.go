@@ -0,0 +1,29 @@
+package vulncheck
+
+import "strings"
+
+// Verdict mirrors gate.Verdict's shape without importing internal/gate,
+// the same way depcheck.Verdict does, so vulncheck stays usable from a
+// plain CLI check or a test without pulling in the rest of the gate
+// package.
+type Verdict struct {
+	Allow   bool
+	Message string
+}
+
+// CheckReport turns a scan Report into a Verdict: any reachable finding
+// blocks the commit, since catching exactly that is this stage's job.
+func CheckReport(report Report) Verdict {
+	if len(report.Findings) == 0 {
+		return Verdict{Allow: true}
+	}
+	return Verdict{Allow: false, Message: "reachable vulnerabilities: " + summarizeFindings(report.Findings)}
+}
+
+func summarizeFindings(findings []Finding) string {
+	parts := make([]string, 0, len(findings))
+	for _, f := range findings {
+		parts = append(parts, f.ID+" in "+f.Package)
+	}
+	return strings.Join(parts, ", ")
+}
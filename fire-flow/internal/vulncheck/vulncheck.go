@@ -0,0 +1,129 @@
+// Package vulncheck runs govulncheck as an optional gate stage: after a
+// cycle reaches GREEN, it scans the packages touched by the attempt for
+// known vulnerabilities that are actually reachable from the call graph,
+// so the gate can block a commit that introduces one.
+package vulncheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+)
+
+// Finding is one reachable vulnerability govulncheck reported, trimmed
+// to what the gate and cycle summary need to show a human.
+type Finding struct {
+	ID      string `json:"id"`
+	Package string `json:"package"`
+	Details string `json:"details"`
+}
+
+// Report is the result of one Scan.
+type Report struct {
+	Findings []Finding `json:"findings"`
+}
+
+// Scanner runs govulncheck against a set of packages.
+type Scanner struct {
+	// GovulncheckPath is the executable to run; defaults to
+	// "govulncheck" on PATH when empty.
+	GovulncheckPath string
+	// CacheDir, when set, is used as GOMODCACHE for the subprocess.
+	// govulncheck fetches its vulnerability database as a Go module, so
+	// pointing GOMODCACHE at a directory that persists between cycles
+	// is enough for the go toolchain to reuse the download instead of
+	// re-fetching it from vuln.go.dev on every scan.
+	CacheDir string
+}
+
+// Scan runs govulncheck -json over packages (import path patterns, e.g.
+// "./...") inside dir and parses its streamed JSON output for findings
+// on the current call graph — an osv entry only becomes a Finding once
+// govulncheck's reachability analysis places it in a finding's trace,
+// so a vulnerable dependency that's merely imported but never called
+// doesn't block the commit.
+func (s Scanner) Scan(ctx context.Context, dir string, packages []string) (Report, error) {
+	bin := s.GovulncheckPath
+	if bin == "" {
+		bin = "govulncheck"
+	}
+	if len(packages) == 0 {
+		packages = []string{"./..."}
+	}
+
+	cmd := exec.CommandContext(ctx, bin, append([]string{"-json"}, packages...)...)
+	cmd.Dir = dir
+	if s.CacheDir != "" {
+		cmd.Env = append(cmd.Environ(), "GOMODCACHE="+s.CacheDir)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	findings, parseErr := parseFindings(stdout.Bytes())
+	if parseErr != nil {
+		if runErr != nil {
+			return Report{}, fmt.Errorf("running govulncheck: %w: %s", runErr, stderr.String())
+		}
+		return Report{}, fmt.Errorf("parsing govulncheck output: %w", parseErr)
+	}
+	return Report{Findings: findings}, nil
+}
+
+// message is one line of govulncheck's -json output stream; each line
+// carries exactly one of these fields.
+type message struct {
+	OSV     *osvEntry     `json:"osv"`
+	Finding *findingEntry `json:"finding"`
+}
+
+type osvEntry struct {
+	ID      string `json:"id"`
+	Details string `json:"details"`
+}
+
+type findingEntry struct {
+	OSV   string       `json:"osv"`
+	Trace []traceEntry `json:"trace"`
+}
+
+type traceEntry struct {
+	Package  string `json:"package"`
+	Function string `json:"function"`
+}
+
+// parseFindings decodes a stream of message objects, pairing each
+// finding with the OSV details reported earlier in the stream, and
+// keeping only findings whose trace reaches an actual function (as
+// opposed to a vulnerability that's merely in the build list).
+func parseFindings(output []byte) ([]Finding, error) {
+	details := map[string]string{}
+	var findings []Finding
+
+	dec := json.NewDecoder(bytes.NewReader(output))
+	for {
+		var msg message
+		if err := dec.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		switch {
+		case msg.OSV != nil:
+			details[msg.OSV.ID] = msg.OSV.Details
+		case msg.Finding != nil && len(msg.Finding.Trace) > 0 && msg.Finding.Trace[0].Function != "":
+			findings = append(findings, Finding{
+				ID:      msg.Finding.OSV,
+				Package: msg.Finding.Trace[0].Package,
+				Details: details[msg.Finding.OSV],
+			})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,131 @@
+// Package watch turns raw filesystem events into a debounced, content-
+// verified stream of "this file actually changed" notifications, so
+// fire-flow's watch mode doesn't trigger a cycle for every save-as-temp
+// dance an editor does.
+package watch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/lprior-repo/fire-flow/internal/vendored"
+)
+
+// Stats reports how much event traffic coalescing absorbed, for verbose
+// logs.
+type Stats struct {
+	RawEvents       int
+	Debounced       int // events collapsed by the debounce window
+	HashUnchanged   int // debounced events whose content hash didn't actually change
+	CyclesTriggered int
+}
+
+// Coalescer batches raw fsnotify events over a debounce window and only
+// reports paths whose content hash actually changed, filtering out the
+// event storms editors produce for temp files and atomic-rename saves.
+type Coalescer struct {
+	watcher *fsnotify.Watcher
+	window  time.Duration
+	hashes  map[string]string
+	Stats   Stats
+
+	// Root is the directory watched paths are relative to, for matching
+	// IgnorePatterns; empty means match against the raw event path.
+	Root string
+	// IgnorePatterns are glob patterns (see internal/vendored) for
+	// paths that never trigger a cycle even when their content changes,
+	// e.g. vendor/ or third_party/ after `go mod vendor` regenerates
+	// them wholesale.
+	IgnorePatterns []string
+}
+
+// NewCoalescer wraps an fsnotify watcher with a debounce window.
+func NewCoalescer(watcher *fsnotify.Watcher, window time.Duration) *Coalescer {
+	return &Coalescer{watcher: watcher, window: window, hashes: make(map[string]string)}
+}
+
+// ignoredPath reports whether path should never trigger a cycle,
+// resolving it relative to Root first when set.
+func (c *Coalescer) ignoredPath(path string) bool {
+	if len(c.IgnorePatterns) == 0 {
+		return false
+	}
+	rel := path
+	if c.Root != "" {
+		if r, err := filepath.Rel(c.Root, path); err == nil {
+			rel = r
+		}
+	}
+	return vendored.Match(rel, c.IgnorePatterns)
+}
+
+// Run blocks, calling onChange once per debounce window with the set of
+// paths that changed content, until the watcher's Events channel closes.
+func (c *Coalescer) Run(onChange func(paths []string)) {
+	pending := make(map[string]struct{})
+	timer := time.NewTimer(c.window)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		var changed []string
+		for path := range pending {
+			sum, err := hashFile(path)
+			if err != nil {
+				// File may have been removed since the event fired;
+				// treat as changed so a delete still triggers a cycle.
+				changed = append(changed, path)
+				delete(c.hashes, path)
+				continue
+			}
+			if c.hashes[path] == sum {
+				c.Stats.HashUnchanged++
+				continue
+			}
+			c.hashes[path] = sum
+			changed = append(changed, path)
+		}
+		pending = make(map[string]struct{})
+		if len(changed) > 0 {
+			c.Stats.CyclesTriggered++
+			onChange(changed)
+		}
+	}
+
+	for {
+		select {
+		case event, ok := <-c.watcher.Events:
+			if !ok {
+				flush()
+				return
+			}
+			c.Stats.RawEvents++
+			if c.ignoredPath(event.Name) {
+				continue
+			}
+			if _, already := pending[event.Name]; already {
+				c.Stats.Debounced++
+			}
+			pending[event.Name] = struct{}{}
+			timer.Reset(c.window)
+		case <-timer.C:
+			flush()
+		}
+	}
+}
+
+func hashFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
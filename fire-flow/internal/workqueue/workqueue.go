@@ -0,0 +1,153 @@
+// Package workqueue persists the daemon's pending work items (queued
+// cycles, pending approvals, outbox sends) to disk, so a daemon restart
+// mid-batch resumes the same queue on startup instead of losing whatever
+// was only held in memory, deduping against items already completed.
+package workqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Item is one unit of work the daemon queues, e.g. a bead cycle to run,
+// an approval awaiting a human, or an outbox message to deliver.
+type Item struct {
+	ID         string          `json:"id"`
+	Kind       string          `json:"kind"`
+	Payload    json.RawMessage `json:"payload"`
+	EnqueuedAt time.Time       `json:"enqueued_at"`
+}
+
+// diskState is the on-disk layout: the still-pending FIFO plus a set of
+// IDs already completed, so a re-enqueue of a completed item's ID (e.g.
+// bd re-surfacing a bead the daemon already finished before restarting)
+// is silently dropped instead of re-run.
+type diskState struct {
+	Pending []Item          `json:"pending"`
+	Done    map[string]bool `json:"done"`
+}
+
+// Queue is a JSON-file-backed FIFO. It is safe for concurrent use
+// through one *Queue value: its lock is an in-process mutex, so workers
+// share a single Queue, and two Queue values (or two processes) on the
+// same path are not serialized against each other.
+type Queue struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewQueue returns a Queue backed by path, created on first Enqueue if
+// it doesn't exist yet.
+func NewQueue(path string) *Queue {
+	return &Queue{Path: path}
+}
+
+// Enqueue adds item to the back of the queue, unless its ID is already
+// pending or already recorded as done.
+func (q *Queue) Enqueue(item Item) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	st, err := q.load()
+	if err != nil {
+		return err
+	}
+	if st.Done[item.ID] {
+		return nil
+	}
+	for _, existing := range st.Pending {
+		if existing.ID == item.ID {
+			return nil
+		}
+	}
+	st.Pending = append(st.Pending, item)
+	return q.save(st)
+}
+
+// Dequeue removes and returns the front item, if any.
+func (q *Queue) Dequeue() (Item, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	st, err := q.load()
+	if err != nil {
+		return Item{}, false, err
+	}
+	if len(st.Pending) == 0 {
+		return Item{}, false, nil
+	}
+	item := st.Pending[0]
+	st.Pending = st.Pending[1:]
+	if err := q.save(st); err != nil {
+		return Item{}, false, err
+	}
+	return item, true, nil
+}
+
+// Complete marks id as done, removing it from Pending if it's still
+// there (a worker may call Complete without having gone through
+// Dequeue, e.g. after processing an item read via Pending). Future
+// Enqueue calls for the same ID are then dropped as duplicates.
+func (q *Queue) Complete(id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	st, err := q.load()
+	if err != nil {
+		return err
+	}
+	filtered := st.Pending[:0]
+	for _, item := range st.Pending {
+		if item.ID != id {
+			filtered = append(filtered, item)
+		}
+	}
+	st.Pending = filtered
+	if st.Done == nil {
+		st.Done = map[string]bool{}
+	}
+	st.Done[id] = true
+	return q.save(st)
+}
+
+// Pending returns a snapshot of the still-queued items, in order, for a
+// daemon to report or replay on startup without consuming them.
+func (q *Queue) Pending() ([]Item, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	st, err := q.load()
+	if err != nil {
+		return nil, err
+	}
+	return append([]Item(nil), st.Pending...), nil
+}
+
+func (q *Queue) load() (diskState, error) {
+	data, err := os.ReadFile(q.Path)
+	if os.IsNotExist(err) {
+		return diskState{Done: map[string]bool{}}, nil
+	}
+	if err != nil {
+		return diskState{}, fmt.Errorf("reading work queue %s: %w", q.Path, err)
+	}
+	var st diskState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return diskState{}, fmt.Errorf("parsing work queue %s: %w", q.Path, err)
+	}
+	if st.Done == nil {
+		st.Done = map[string]bool{}
+	}
+	return st, nil
+}
+
+func (q *Queue) save(st diskState) error {
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling work queue: %w", err)
+	}
+	if err := os.WriteFile(q.Path, data, 0o644); err != nil {
+		return fmt.Errorf("writing work queue %s: %w", q.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Package client is a typed Go client for the fire-flow daemon's control
+// API (internal/daemon), so other internal tools can integrate with
+// fire-flow programmatically instead of shelling out to the fire-flow
+// binary or hand-rolling HTTP calls against undocumented routes.
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/lprior-repo/fire-flow/internal/capability"
+)
+
+// Client talks to a fire-flow daemon's control API over HTTP.
+type Client struct {
+	BaseURL string
+	Token   string
+	HTTP    *http.Client
+}
+
+// New returns a Client for the daemon at baseURL, authenticating with
+// token as a bearer token (see internal/daemon/rbac.go).
+func New(baseURL, token string) *Client {
+	return &Client{BaseURL: baseURL, Token: token, HTTP: http.DefaultClient}
+}
+
+// ProjectStatus mirrors one entry of GET /status.
+type ProjectStatus struct {
+	Name          string         `json:"name"`
+	Mode          string         `json:"mode"`
+	Paused        bool           `json:"paused"`
+	Stats         map[string]any `json:"stats"`
+	OutboxPending int            `json:"outbox_pending"`
+	Error         string         `json:"error,omitempty"`
+}
+
+// Status returns the current status of every registered project.
+func (c *Client) Status(ctx context.Context) ([]ProjectStatus, error) {
+	var statuses []ProjectStatus
+	if err := c.do(ctx, http.MethodGet, "/status", nil, &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// StartCycle requests a cycle for project. It corresponds to POST /run;
+// the daemon currently only queues and acknowledges the request (see
+// the comment on handleRun in internal/daemon/api.go), so a successful
+// return means "queued", not "committed".
+func (c *Client) StartCycle(ctx context.Context, project string) error {
+	return c.do(ctx, http.MethodPost, "/run?project="+project, nil, nil)
+}
+
+// NextBead is not yet exposed by the daemon's API; there is no route to
+// wrap. It returns an error rather than silently no-op'ing so callers
+// notice the gap instead of assuming success.
+func (c *Client) NextBead(ctx context.Context, project string) (string, error) {
+	return "", fmt.Errorf("client: NextBead has no daemon endpoint yet (see internal/daemon/api.go)")
+}
+
+// RunAI is not yet exposed by the daemon's API, for the same reason as
+// NextBead.
+func (c *Client) RunAI(ctx context.Context, project, beadID string) error {
+	return fmt.Errorf("client: RunAI has no daemon endpoint yet (see internal/daemon/api.go)")
+}
+
+// Tail streams a running session's event log, calling onLine for each
+// line as it arrives, until ctx is canceled or the daemon closes the
+// connection (typically because the session ended). It corresponds to
+// GET /tail?session=....
+func (c *Client) Tail(ctx context.Context, session string, onLine func(string)) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/tail?session="+session, nil)
+	if err != nil {
+		return fmt.Errorf("building tail request: %w", err)
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("attaching to tail for session %s: %w", session, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("tail %s: status %d: %s", session, resp.StatusCode, string(data))
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		onLine(scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// Capabilities returns the daemon host's detected feature set, as
+// reported by GET /capabilities.
+func (c *Client) Capabilities(ctx context.Context) (capability.Set, error) {
+	var set capability.Set
+	if err := c.do(ctx, http.MethodGet, "/capabilities", nil, &set); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshaling request body: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("building request for %s %s: %w", method, path, err)
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s %s: status %d: %s", method, path, resp.StatusCode, string(data))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response from %s %s: %w", method, path, err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Package overlay is the stable, public surface over fire-flow's overlay
+// filesystem layer, for callers that only want isolated writable views
+// of a source tree without pulling in TCR-specific glue (bead wiring,
+// chaos injection, mount registries). internal/overlay remains the
+// place for that glue; this package wraps it with context support and
+// an options pattern, and never picks a temp directory on the caller's
+// behalf.
+package overlay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lprior-repo/fire-flow/internal/overlay"
+)
+
+// Session is a live overlay mount; see internal/overlay.Session for the
+// underlying type and semantics.
+type Session = overlay.Session
+
+// Options describes a single overlay mount. Every directory must be
+// supplied by the caller — this package never defaults to os.TempDir or
+// any other global path, so two Managers never collide by accident.
+type Options struct {
+	Lower  string
+	Upper  string
+	Work   string
+	Merged string
+	Labels map[string]string
+}
+
+// Option mutates an Options value; see WithLabels and friends.
+type Option func(*Options)
+
+// WithLabels sets the mount's labels, propagated to the underlying
+// mount registry for status/ps/gc.
+func WithLabels(labels map[string]string) Option {
+	return func(o *Options) { o.Labels = labels }
+}
+
+// Manager mounts and tracks overlay sessions on behalf of a caller
+// outside fire-flow's own TCR loop.
+type Manager struct {
+	mounter overlay.Mounter
+}
+
+// NewManager wraps mounter (typically overlay.NewLinuxMounter() or
+// overlay.NewFakeMounter() from internal/overlay) for use through this
+// package's context-aware API.
+func NewManager(mounter overlay.Mounter) *Manager {
+	return &Manager{mounter: mounter}
+}
+
+// Mount creates an overlay session for lower/upper/work/merged, applying
+// opts. It respects ctx cancellation before starting the mount syscall;
+// the underlying mount itself is not currently interruptible mid-flight,
+// since the kernel call is not one fire-flow can safely abort partway.
+func (m *Manager) Mount(ctx context.Context, lower, upper, work, merged string, opts ...Option) (*Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("overlay mount canceled before starting: %w", err)
+	}
+	o := Options{Lower: lower, Upper: upper, Work: work, Merged: merged}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	session, err := m.mounter.Mount(overlay.MountConfig{
+		Lower:  o.Lower,
+		Upper:  o.Upper,
+		Work:   o.Work,
+		Merged: o.Merged,
+		Labels: o.Labels,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mounting overlay at %s: %w", o.Merged, err)
+	}
+	return session, nil
+}